@@ -1,17 +1,24 @@
 package money
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"math"
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/govalues/decimal"
-)
-
-var (
-	errAmountOverflow   = errors.New("amount overflow")
-	errCurrencyMismatch = errors.New("currency mismatch")
+	"golang.org/x/text/language"
 )
 
 // Amount type represents a monetary amount.
@@ -33,7 +40,7 @@ func newAmountSafe(m Currency, d decimal.Decimal) (Amount, error) {
 	if d.Scale() < m.Scale() {
 		d = d.Pad(m.Scale())
 		if d.Scale() < m.Scale() {
-			return Amount{}, fmt.Errorf("padding amount: %w", errAmountOverflow)
+			return Amount{}, fmt.Errorf("padding amount: %w", ErrOverflow)
 		}
 	}
 	return newAmountUnsafe(m, d), nil
@@ -170,6 +177,82 @@ func (a Amount) Int64(scale int) (whole, frac int64, ok bool) {
 	return a.Decimal().Int64(scale)
 }
 
+// MajorMinor returns a pair of integers representing the major units
+// (e.g. dollars, pounds, yuans) and the minor units (e.g. cents, pennies, fens)
+// of the amount.
+// It is equivalent to [Amount.Int64] with the scale of the currency, so the
+// minor units are rounded using [rounding half to even] (banker's rounding)
+// and have the same sign as the major units.
+// For example, USD -12.34 is represented as (-12, -34).
+// See also methods [Amount.MinorUnits], [Amount.Int64].
+//
+// MajorMinor returns false if the result cannot be represented as a pair of int64 values.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) MajorMinor() (major, minor int64, ok bool) {
+	return a.Int64(a.Curr().Scale())
+}
+
+// NewAmountFromProto converts the fields of a [google.type.Money] message to
+// an amount.
+// The units are the whole units of the currency, and the nanos are
+// the number of nano (10^-9) units of the amount.
+// See also method [Amount.Proto].
+//
+// NewAmountFromProto returns an error if:
+//   - the currency code is not valid;
+//   - the nanos are not within the range [-999,999,999, 999,999,999];
+//   - the units and nanos have different signs;
+//   - the nanos have non-zero digits beyond the scale of the currency.
+//     For example, when currency is US Dollars, nanos must be a multiple of 10,000,000.
+//
+// [google.type.Money]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
+func NewAmountFromProto(curr string, units int64, nanos int32) (Amount, error) {
+	// Currency
+	m, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	// Nanos
+	if nanos <= -1_000_000_000 || nanos >= 1_000_000_000 {
+		return Amount{}, fmt.Errorf("converting nanos: %v is out of range", nanos)
+	}
+	if units > 0 && nanos < 0 || units < 0 && nanos > 0 {
+		return Amount{}, fmt.Errorf("converting nanos: units and nanos have different signs")
+	}
+	if s := m.Scale(); s < 9 && int64(nanos)%int64(pow10[9-s]) != 0 {
+		return Amount{}, fmt.Errorf("converting nanos: %v has more than %v digits after the decimal point", nanos, m.Scale())
+	}
+	// Amount
+	return NewAmountFromInt64(curr, units, int64(nanos), 9)
+}
+
+// Proto returns the fields of a [google.type.Money] message representing
+// the amount.
+// See also constructor [NewAmountFromProto].
+//
+// Proto returns an error if:
+//   - the amount has non-zero digits beyond the scale of its currency;
+//   - the amount has non-zero digits beyond nanos, which is possible for
+//     currencies registered with a scale greater than 9;
+//   - the integer part of the amount cannot be represented as an int64.
+//
+// [google.type.Money]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
+func (a Amount) Proto() (curr string, units int64, nanos int32, err error) {
+	if a.TrimToCurr().Scale() > a.Curr().Scale() {
+		return "", 0, 0, fmt.Errorf("converting %v to protobuf: amount has more than %v digits after the decimal point", a, a.Curr().Scale())
+	}
+	if d := a.Decimal(); d.Trim(9).Scale() > 9 {
+		return "", 0, 0, fmt.Errorf("converting %v to protobuf: amount has more than 9 digits after the decimal point", a)
+	}
+	units, frac, ok := a.Int64(9)
+	if !ok {
+		return "", 0, 0, fmt.Errorf("converting %v to protobuf: %w", a, ErrOverflow)
+	}
+	//nolint:gosec
+	return a.Curr().Code(), units, int32(frac), nil
+}
+
 // NewAmountFromMinorUnits converts an integer, representing minor units of
 // currency (e.g. cents, pennies, fens), to an amount.
 // See also method [Amount.MinorUnits].
@@ -217,6 +300,12 @@ func (a Amount) MinorUnits() (units int64, ok bool) {
 }
 
 // NewAmountFromFloat64 converts a float to a (possibly rounded) amount.
+// The amount keeps all digits of the shortest decimal representation of the
+// float, which may be more than the scale of the currency.
+// Binary floats cannot represent most decimal fractions exactly, so amounts
+// should be converted to floats only at integration boundaries, and
+// [NewAmountFromFloat64Round] should be preferred when the amount is
+// expected to be in minor units of the currency.
 // See also method [Amount.Float64].
 //
 // NewAmountFromFloat64 returns an error if:
@@ -241,6 +330,19 @@ func NewAmountFromFloat64(curr string, amount float64) (Amount, error) {
 	return a, nil
 }
 
+// NewAmountFromFloat64Round is like [NewAmountFromFloat64] but also rounds
+// the amount to the scale of the currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// For example, the float64 sum of 0.1 and 0.2 is converted to USD 0.30
+// rather than USD 0.30000000000000004.
+func NewAmountFromFloat64Round(curr string, amount float64, mode ...RoundingMode) (Amount, error) {
+	a, err := NewAmountFromFloat64(curr, amount)
+	if err != nil {
+		return Amount{}, err
+	}
+	return a.RoundWith(a.Curr().Scale(), mode...), nil
+}
+
 // Float64 returns the nearest binary floating-point number rounded
 // using [rounding half to even] (banker's rounding).
 // See also constructor [NewAmountFromFloat64].
@@ -267,6 +369,10 @@ func MustParseAmount(curr, amount string) Amount {
 // If the scale of the amount is less than the scale of the currency, the result
 // will be zero-padded to the right.
 // See also constructors [ParseCurr] and [decimal.Parse].
+//
+// ParseAmount returns an error if:
+//   - the currency code is not valid, see [UnknownCurrencyError];
+//   - the numeric string is not valid or has too many digits, see [InvalidAmountError].
 func ParseAmount(curr, amount string) (Amount, error) {
 	// Currency
 	m, err := ParseCurr(curr)
@@ -276,12 +382,81 @@ func ParseAmount(curr, amount string) (Amount, error) {
 	// Decimal
 	d, err := decimal.ParseExact(amount, m.Scale())
 	if err != nil {
-		return Amount{}, fmt.Errorf("parsing amount: %w", err)
+		return Amount{}, fmt.Errorf("parsing amount: %w", &InvalidAmountError{Input: amount, Err: decimalErr(err)})
 	}
 	// Amount
 	return newAmountSafe(m, d)
 }
 
+// shorthandLookup maps shorthand suffixes to the corresponding multipliers.
+var shorthandLookup = map[string]int64{
+	"k":  1_000,             // thousand
+	"m":  1_000_000,         // million
+	"mn": 1_000_000,         // million
+	"bn": 1_000_000_000,     // billion
+	"tn": 1_000_000_000_000, // trillion
+}
+
+// ParseShorthand converts currency and numeric string with an optional
+// shorthand suffix to a (possibly rounded) amount.
+// The following case-insensitive suffixes are supported:
+//
+//	| Suffix  | Example | Multiplier        |
+//	| ------- | ------- | ----------------- |
+//	| k       | 5k      | 1,000             |
+//	| m, mn   | 1.2m    | 1,000,000         |
+//	| bn      | 3bn     | 1,000,000,000     |
+//	| tn      | 0.5tn   | 1,000,000,000,000 |
+//
+// This function is useful for speeding up data entry in administrative tools.
+// See also constructor [ParseAmount].
+//
+// ParseShorthand returns an error if:
+//   - the currency code is not valid;
+//   - the suffix is not supported, for example "b" and "mm" are rejected
+//     since their meaning varies between conventions;
+//   - the numeric part is not a valid decimal;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ParseShorthand(curr, amount string) (Amount, error) {
+	a, err := parseShorthand(curr, amount)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing shorthand %q: %w", amount, err)
+	}
+	return a, nil
+}
+
+func parseShorthand(curr, amount string) (Amount, error) {
+	// Suffix
+	i := len(amount)
+	for i > 0 && isLetter(amount[i-1]) {
+		i--
+	}
+	num, suf := amount[:i], strings.ToLower(amount[i:])
+	if suf == "" {
+		return ParseAmount(curr, num)
+	}
+	mult, ok := shorthandLookup[suf]
+	if !ok {
+		return Amount{}, fmt.Errorf("unknown suffix %q", suf)
+	}
+	// Number
+	a, err := ParseAmount(curr, num)
+	if err != nil {
+		return Amount{}, err
+	}
+	// Multiplier
+	e, err := decimal.New(mult, 0)
+	if err != nil {
+		return Amount{}, err
+	}
+	a, err = a.mul(e)
+	if err != nil {
+		return Amount{}, err
+	}
+	return a.TrimToCurr(), nil
+}
+
 // String implements the [fmt.Stringer] interface and returns a string
 // representation of an amount.
 // See also methods [Currency.String], [Decimal.String], [Amount.Format].
@@ -306,17 +481,130 @@ func (a Amount) append(text []byte) []byte {
 	return text
 }
 
+// OFXAmount returns a string representation of the amount suitable for
+// the TRNAMT and BALAMT elements of an [OFX] document.
+// The amount is rounded to the scale of its currency using
+// [rounding half to even] (banker's rounding) and formatted with a dot
+// as the decimal separator, a leading minus sign for negative amounts,
+// and without digit grouping or currency code.
+// See also method [Amount.QIFAmount].
+//
+// [OFX]: https://www.financialdataexchange.org/FDX/About/OFX-Work-Group.aspx
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) OFXAmount() string {
+	d := a.RoundToCurr().Decimal()
+	return d.String()
+}
+
+// QIFAmount returns a string representation of the amount suitable for
+// the T, U, and $ fields of a [QIF] file.
+// The amount is rounded to the scale of its currency using
+// [rounding half to even] (banker's rounding) and formatted with a dot
+// as the decimal separator, a comma as the thousands separator,
+// a leading minus sign for negative amounts, and without currency code.
+// See also method [Amount.OFXAmount].
+//
+// [QIF]: https://en.wikipedia.org/wiki/Quicken_Interchange_Format
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) QIFAmount() string {
+	d := a.RoundToCurr().Decimal()
+	text := make([]byte, 0, 32)
+	text = appendGrouped(text, d, ",", ".")
+	return string(text)
+}
+
+// FormatTAccount returns a localized representation of the amount placed in
+// the debit or credit column of a [T-account].
+// Positive amounts are returned in the debit column and negative amounts are
+// returned in the credit column, in both cases without an arithmetic sign.
+// The other column is an empty string.
+// The amount is rounded to the scale of its currency using
+// [rounding half to even] (banker's rounding), and if the result is zero,
+// both columns are empty strings.
+//
+// [T-account]: https://en.wikipedia.org/wiki/Debits_and_credits#T-accounts
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) FormatTAccount(tag language.Tag) (debit, credit string) {
+	a = a.RoundToCurr()
+	if a.IsZero() {
+		return "", ""
+	}
+	loc := lookupLocale(tag)
+	text := make([]byte, 0, 32)
+	text = loc.appendAmount(text, a.Abs())
+	if a.IsNeg() {
+		return "", string(text)
+	}
+	return string(text), ""
+}
+
+// FormatTrimWhole returns a localized representation of the amount, omitting
+// the fractional part if the amount is a whole number, for example "$5" and "$5.50".
+// The amount is rounded to the scale of its currency using
+// [rounding half to even] (banker's rounding) before formatting.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) FormatTrimWhole(tag language.Tag) string {
+	a = a.RoundToCurr()
+	if a.IsInt() {
+		m, d := a.Curr(), a.Decimal()
+		a = newAmountUnsafe(m, d.Trunc(0))
+	}
+	loc := lookupLocale(tag)
+	text := make([]byte, 0, 32)
+	text = loc.appendAmount(text, a)
+	return string(text)
+}
+
+// appendGrouped appends a string representation of the decimal to the byte slice,
+// separating groups of 3 integer digits with the group separator.
+func appendGrouped(text []byte, d decimal.Decimal, group, point string) []byte {
+	if d.IsNeg() {
+		text = append(text, '-')
+	}
+	// Digits, including leading zeros, are written right to left into
+	// a buffer on the stack to avoid heap allocations.
+	var buf [decimal.MaxPrec + 1]byte
+	scale := d.Scale()
+	i := len(buf)
+	for coef := d.Coef(); coef > 0 || len(buf)-i <= scale; coef /= 10 {
+		i--
+		buf[i] = byte('0' + coef%10)
+	}
+	digs := buf[i:]
+	intdigs := len(digs) - scale
+	// Integer part
+	for i := range intdigs {
+		if i > 0 && (intdigs-i)%3 == 0 {
+			text = append(text, group...)
+		}
+		text = append(text, digs[i])
+	}
+	// Fractional part
+	if scale > 0 {
+		text = append(text, point...)
+		text = append(text, digs[intdigs:]...)
+	}
+	return text
+}
+
 // Format implements the [fmt.Formatter] interface.
 // The following [format verbs] are available:
 //
 //	| Verb   | Example     | Description                |
 //	| ------ | ----------- | -------------------------- |
-//	| %s, %v | USD 5.678   | Currency and amount        |
+//	| %v     | USD 5.678   | Currency and amount        |
+//	| %s     | $5.678      | Currency symbol and amount |
 //	| %q     | "USD 5.678" | Quoted currency and amount |
 //	| %f     | 5.678       | Amount                     |
 //	| %d     | 568         | Amount in minor units      |
 //	| %c     | USD         | Currency                   |
 //
+// The %s verb uses the English symbol of the currency, see [Currency.Symbol],
+// and places the sign before the symbol, for example -$5.68.
+// Currencies without a symbol are written with their code, for example CHF 5.68.
+// Use [Formatter] for localized symbols, separators, and symbol positions.
+//
 // The '-' format flag can be used with all verbs.
 // The '+', ' ', '0' format flags can be used with all verbs except %c.
 //
@@ -392,6 +680,12 @@ func (a Amount) Format(state fmt.State, verb rune) {
 	case 'c', 'C':
 		curr = m.Code()
 		currsyms = len(curr)
+	case 's', 'S':
+		curr = m.Symbol()
+		currsyms = len(curr)
+		if currsyms > 0 && isLetter(curr[currsyms-1]) {
+			currdel = 1
+		}
 	default:
 		curr = m.Code()
 		currsyms = len(curr)
@@ -404,19 +698,20 @@ func (a Amount) Format(state fmt.State, verb rune) {
 		lquote, tquote = 1, 1
 	}
 
-	// Calculating padding
+	// Calculating padding, symbols such as € take several bytes but one column
 	width := lquote + currsyms + currdel + rsign + intdigs + dpoint + fracdigs + tzeros + tquote
+	cols := width - currsyms + utf8.RuneCountInString(curr)
 	var lspaces, lzeros, tspaces int
-	if w, ok := state.Width(); ok && w > width {
+	if w, ok := state.Width(); ok && w > cols {
 		switch {
 		case state.Flag('-'):
-			tspaces = w - width
+			tspaces = w - cols
 		case state.Flag('0') && verb != 'c' && verb != 'C':
-			lzeros = w - width
+			lzeros = w - cols
 		default:
-			lspaces = w - width
+			lspaces = w - cols
 		}
-		width = w
+		width += w - cols
 	}
 
 	buf := make([]byte, width)
@@ -467,16 +762,20 @@ func (a Amount) Format(state fmt.State, verb rune) {
 		pos--
 	}
 
-	// Arithmetic sign
-	for range rsign {
-		if d.IsNeg() {
-			buf[pos] = '-'
-		} else if state.Flag(' ') {
-			buf[pos] = ' '
-		} else {
-			buf[pos] = '+'
+	// Arithmetic sign, placed before the currency symbol for the %s verb
+	sign := byte('+')
+	switch {
+	case d.IsNeg():
+		sign = '-'
+	case state.Flag(' '):
+		sign = ' '
+	}
+	signfirst := verb == 's' || verb == 'S'
+	if !signfirst {
+		for range rsign {
+			buf[pos] = sign
+			pos--
 		}
-		pos--
 	}
 
 	// Currency delimiter
@@ -485,12 +784,19 @@ func (a Amount) Format(state fmt.State, verb rune) {
 		pos--
 	}
 
-	// Currency code
+	// Currency code or symbol
 	for i := range currsyms {
 		buf[pos] = curr[currsyms-i-1]
 		pos--
 	}
 
+	if signfirst {
+		for range rsign {
+			buf[pos] = sign
+			pos--
+		}
+	}
+
 	// Opening quote
 	for range lquote {
 		buf[pos] = '"'
@@ -517,6 +823,507 @@ func (a Amount) Format(state fmt.State, verb rune) {
 	}
 }
 
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The following representations are accepted:
+//
+//	{"amount":"12.34","currency":"USD"}
+//	{"amount":12.34,"currency":"USD"}
+//	"USD 12.34"
+//
+// See also constructor [ParseAmount].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var err error
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err = json.Unmarshal(data, &s); err == nil {
+			*a, err = parseTextAmount(s)
+		}
+	} else {
+		var v struct {
+			Amount   json.RawMessage `json:"amount"`
+			Currency string          `json:"currency"`
+		}
+		if err = json.Unmarshal(data, &v); err == nil {
+			*a, err = ParseAmount(v.Currency, strings.Trim(string(v.Amount), `"`))
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Amount{}, err)
+	}
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// MarshalJSON always returns an object with the amount as a string and
+// the currency as a 3-letter code, for example {"amount":"12.34","currency":"USD"}.
+// To represent an amount as a string or as minor units, use types
+// [TextAmount] or [MinorUnits].
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (a Amount) MarshalJSON() ([]byte, error) {
+	text := make([]byte, 0, 48)
+	text = append(text, `{"amount":"`...)
+	text, _ = a.Decimal().AppendText(text) // Decimal.AppendText is always successful
+	text = append(text, `","currency":"`...)
+	text = append(text, a.Curr().Code()...)
+	text = append(text, `"}`...)
+	return text, nil
+}
+
+// UnmarshalYAML implements the [yaml.Unmarshaler] interface of the
+// gopkg.in/yaml.v2 package, which is also supported by gopkg.in/yaml.v3
+// and github.com/goccy/go-yaml.
+// The following representations are accepted:
+//
+//	price: USD 9.99
+//	price: 9.99 USD
+//	price: {amount: 9.99, currency: USD}
+//
+// Numbers are never converted to floating-point, so the amount keeps all
+// its digits.
+// See also constructor [ParseAmount].
+//
+// [yaml.Unmarshaler]: https://pkg.go.dev/gopkg.in/yaml.v2#Unmarshaler
+func (a *Amount) UnmarshalYAML(unmarshal func(any) error) error {
+	var err error
+	var s string
+	if err = unmarshal(&s); err == nil {
+		*a, err = parseYAMLAmount(s)
+	} else {
+		var v struct {
+			Amount   string `yaml:"amount"`
+			Currency string `yaml:"currency"`
+		}
+		if err = unmarshal(&v); err == nil {
+			*a, err = ParseAmount(v.Currency, v.Amount)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Amount{}, err)
+	}
+	return nil
+}
+
+// parseYAMLAmount converts a string in the "USD 9.99" or "9.99 USD"
+// format to amount.
+func parseYAMLAmount(s string) (Amount, error) {
+	curr, amount, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return Amount{}, fmt.Errorf("missing currency or amount")
+	}
+	amount = strings.TrimSpace(amount)
+	if strings.IndexFunc(curr, isDigit) >= 0 {
+		curr, amount = amount, curr
+	}
+	return ParseAmount(curr, amount)
+}
+
+// MarshalYAML implements the [yaml.Marshaler] interface of the
+// gopkg.in/yaml.v2 and gopkg.in/yaml.v3 packages.
+// MarshalYAML always returns a string in the "USD 9.99" format.
+// See also method [Amount.String].
+//
+// [yaml.Marshaler]: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (a Amount) MarshalYAML() (any, error) {
+	return a.String(), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// The text must be in the "USD 12.34" format.
+// See also constructor [ParseAmount].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (a *Amount) UnmarshalText(text []byte) error {
+	var err error
+	*a, err = parseTextAmount(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Amount{}, err)
+	}
+	return nil
+}
+
+// parseTextAmount converts a string in the "USD 12.34" format to amount.
+func parseTextAmount(s string) (Amount, error) {
+	curr, amount, ok := strings.Cut(s, " ")
+	if !ok {
+		return Amount{}, fmt.Errorf("missing currency or amount")
+	}
+	return ParseAmount(curr, amount)
+}
+
+// AppendText implements the [encoding.TextAppender] interface.
+// AppendText always appends text in the "USD 12.34" format.
+// See also method [Amount.String].
+//
+// [encoding.TextAppender]: https://pkg.go.dev/encoding#TextAppender
+func (a Amount) AppendText(text []byte) ([]byte, error) {
+	return a.append(text), nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// MarshalText always returns text in the "USD 12.34" format.
+// See also method [Amount.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (a Amount) MarshalText() ([]byte, error) {
+	return a.bytes(), nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// The data must be in the fixed-width format produced by [Amount.MarshalBinary].
+// See also constructor [NewAmountFromDecimal].
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (a *Amount) UnmarshalBinary(data []byte) error {
+	var err error
+	*a, err = parseBinaryAmount(data)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Amount{}, err)
+	}
+	return nil
+}
+
+// parseBinaryAmount converts data in the format produced by
+// [Amount.AppendBinary] to amount.
+func parseBinaryAmount(data []byte) (Amount, error) {
+	if len(data) != binaryLen {
+		return Amount{}, fmt.Errorf("invalid data length %v, want %v", len(data), binaryLen)
+	}
+	code := string(data[:3])
+	if strings.IndexFunc(code, isNotUpper) >= 0 {
+		return Amount{}, &UnknownCurrencyError{Code: code}
+	}
+	m, err := ParseCurr(code)
+	if err != nil {
+		return Amount{}, err
+	}
+	sign := data[3]
+	if sign > 1 {
+		return Amount{}, fmt.Errorf("invalid sign %v", sign)
+	}
+	coef := binary.BigEndian.Uint64(data[4:12])
+	if coef >= pow10[decimal.MaxPrec] || coef == 0 && sign == 1 {
+		return Amount{}, fmt.Errorf("invalid coefficient %v", coef)
+	}
+	scale := int(data[12])
+	if scale > decimal.MaxScale {
+		return Amount{}, fmt.Errorf("invalid scale %v", scale)
+	}
+	// The coefficient may not fit into an int64, so its last digit is
+	// added separately.
+	d := decimal.MustNew(int64(coef/10), 0) //nolint:gosec
+	d, err = d.Mul(decimal.Ten)
+	if err != nil {
+		return Amount{}, err
+	}
+	d, err = d.Add(decimal.MustNew(int64(coef%10), 0)) //nolint:gosec
+	if err != nil {
+		return Amount{}, err
+	}
+	d, err = d.Mul(decimal.MustNew(1, scale))
+	if err != nil {
+		return Amount{}, err
+	}
+	if sign == 1 {
+		d = d.Neg()
+	}
+	return newAmountSafe(m, d)
+}
+
+// binaryLen is the length of the binary encoding of an amount.
+const binaryLen = 3 + 1 + 8 + 1
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// AppendBinary always appends 13 bytes in the following format:
+//
+//	3 bytes: currency code, for example "USD"
+//	1 byte:  sign, 0 for zero and positive amounts, 1 for negative amounts
+//	8 bytes: coefficient, big-endian
+//	1 byte:  scale of the coefficient
+//
+// Unlike [Amount.AppendCanonical], the coefficient keeps its trailing zeros,
+// so the amount is decoded with its original scale.
+//
+// [encoding.BinaryAppender]: https://pkg.go.dev/encoding#BinaryAppender
+func (a Amount) AppendBinary(data []byte) ([]byte, error) {
+	code := a.Curr().Code()
+	if code == "" {
+		return nil, fmt.Errorf("marshaling %T: %w", a, errInvalidCurrency)
+	}
+	d := a.Decimal()
+	data = append(data, code...)
+	if d.IsNeg() {
+		data = append(data, 1)
+	} else {
+		data = append(data, 0)
+	}
+	data = binary.BigEndian.AppendUint64(data, d.Coef())
+	return append(data, byte(d.Scale())), nil //nolint:gosec
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// MarshalBinary always returns 13 bytes in the format described in
+// [Amount.AppendBinary], which makes amounts usable with [encoding/gob]
+// and in fixed-width records of binary caches.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (a Amount) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, binaryLen)
+	return a.AppendBinary(data)
+}
+
+// Scan implements the [sql.Scanner] interface.
+// The value must be a string in one of the following formats:
+//
+//	USD 12.34
+//	(USD,12.34)
+//
+// The second format is used by PostgreSQL for values of composite types,
+// for example:
+//
+//	CREATE TYPE money_amount AS (curr char(3), amount numeric);
+//
+// See also constructor [ParseAmount] and type [NullAmount].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (a *Amount) Scan(value any) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*a, err = parseSQLAmount(value)
+	case []byte:
+		*a, err = parseSQLAmount(string(value))
+	case nil:
+		err = fmt.Errorf("%T does not support null values, use %T or *%T", Amount{}, NullAmount{}, Amount{})
+	default:
+		err = fmt.Errorf("type %T is not supported", value)
+	}
+	if err != nil {
+		err = fmt.Errorf("converting from %T to %T: %w", value, Amount{}, err)
+	}
+	return err
+}
+
+// parseSQLAmount converts a string in the "USD 12.34" or "(USD,12.34)"
+// format to amount.
+func parseSQLAmount(s string) (Amount, error) {
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return parseTextAmount(s)
+	}
+	curr, amount, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return Amount{}, fmt.Errorf("missing currency or amount")
+	}
+	return ParseAmount(strings.TrimSpace(curr), amount)
+}
+
+// Value implements the [driver.Valuer] interface and returns a string
+// representation of the amount, for example "USD 12.34".
+// To store the amount in separate columns, use [Amount.Curr] together with
+// [Amount.Decimal] for a NUMERIC column, or type [MinorUnits] for an integer
+// column.
+// See also method [Amount.String].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
+// The value must be an embedded document with the following fields:
+//
+//	{amount: NumberDecimal("12.34"), currency: "USD"}
+//	{amount: NumberLong(1234), currency: "USD"}
+//
+// The amount can be a [Decimal128] or a string with a decimal number.
+// Documents written by older applications that store the amount as a 32-bit
+// or 64-bit integer are also supported, in this case the integer is
+// interpreted as a number of minor units, see type [MinorUnits].
+// Other fields of the document are ignored.
+// See also constructor [NewAmountFromDecimal] and type [NullAmount].
+//
+// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
+// [Decimal128]: https://github.com/mongodb/specifications/blob/master/source/bson-decimal128/decimal128.md
+func (a *Amount) UnmarshalBSONValue(typ byte, data []byte) error {
+	// constants are from https://bsonspec.org/spec.html
+	var err error
+	switch typ {
+	case 3:
+		*a, err = parseBSONAmount(data)
+	case 10:
+		err = fmt.Errorf("%T does not support null values, use %T or *%T", Amount{}, NullAmount{}, Amount{})
+	default:
+		err = fmt.Errorf("BSON type %d is not supported", typ)
+	}
+	if err != nil {
+		err = fmt.Errorf("converting from BSON type %d to %T: %w", typ, Amount{}, err)
+	}
+	return err
+}
+
+// MarshalBSONValue implements the [v2/bson.ValueMarshaler] interface.
+// MarshalBSONValue always returns an embedded document with the amount as
+// a [Decimal128] and the currency as a 3-letter code, for example
+// {amount: NumberDecimal("12.34"), currency: "USD"}.
+// Such documents can be queried and aggregated by MongoDB without losing
+// precision.
+//
+// [v2/bson.ValueMarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueMarshaler
+// [Decimal128]: https://github.com/mongodb/specifications/blob/master/source/bson-decimal128/decimal128.md
+func (a Amount) MarshalBSONValue() (typ byte, data []byte, err error) {
+	_, amount, _ := a.Decimal().MarshalBSONValue() // Decimal.MarshalBSONValue is always successful
+	curr := a.Curr().bsonString()
+	l := 4 + 1 + len("amount") + 1 + len(amount) + 1 + len("currency") + 1 + len(curr) + 1
+	data = make([]byte, 4, l)
+	data[0] = byte(l)
+	data[1] = byte(l >> 8)
+	data[2] = byte(l >> 16)
+	data[3] = byte(l >> 24)
+	data = append(data, 19)
+	data = append(data, "amount\x00"...)
+	data = append(data, amount...)
+	data = append(data, 2)
+	data = append(data, "currency\x00"...)
+	data = append(data, curr...)
+	data = append(data, 0)
+	return 3, data, nil
+}
+
+// parseBSONAmount parses a BSON embedded document to amount.
+// The byte order of the input data must be little-endian.
+func parseBSONAmount(data []byte) (Amount, error) {
+	if len(data) < 5 {
+		return Amount{}, fmt.Errorf("invalid data length %v", len(data))
+	}
+	u := uint32(data[0])
+	u |= uint32(data[1]) << 8
+	u |= uint32(data[2]) << 16
+	u |= uint32(data[3]) << 24
+	if uint64(u) != uint64(len(data)) || data[len(data)-1] != 0 {
+		return Amount{}, fmt.Errorf("invalid document length %v", u)
+	}
+
+	// Fields
+	var currTyp, amountTyp byte
+	var currData, amountData []byte
+	for rest := data[4 : len(data)-1]; len(rest) > 0; {
+		typ := rest[0]
+		end := bytes.IndexByte(rest[1:], 0)
+		if end < 0 {
+			return Amount{}, fmt.Errorf("invalid field name")
+		}
+		name := string(rest[1 : 1+end])
+		rest = rest[1+end+1:]
+		l, err := bsonValueLen(typ, rest)
+		if err != nil {
+			return Amount{}, fmt.Errorf("field %q: %w", name, err)
+		}
+		switch name {
+		case "currency":
+			currTyp, currData = typ, rest[:l]
+		case "amount":
+			amountTyp, amountData = typ, rest[:l]
+		}
+		rest = rest[l:]
+	}
+
+	// Currency
+	var m Currency
+	switch currTyp {
+	case 0:
+		return Amount{}, fmt.Errorf("missing currency")
+	case 2:
+		var err error
+		m, err = parseBSONString(currData)
+		if err != nil {
+			return Amount{}, err
+		}
+	default:
+		return Amount{}, fmt.Errorf("currency of BSON type %d is not supported", currTyp)
+	}
+
+	// Amount
+	var d decimal.Decimal
+	switch amountTyp {
+	case 0:
+		return Amount{}, fmt.Errorf("missing amount")
+	case 2, 19:
+		if err := d.UnmarshalBSONValue(amountTyp, amountData); err != nil {
+			return Amount{}, err
+		}
+	case 16, 18:
+		// Minor units
+		var units int64
+		for i := len(amountData) - 1; i >= 0; i-- {
+			units = units<<8 | int64(amountData[i])
+		}
+		if amountTyp == 16 {
+			units = int64(int32(units)) //nolint:gosec
+		}
+		return NewAmountFromMinorUnits(m.Code(), units)
+	default:
+		return Amount{}, fmt.Errorf("amount of BSON type %d is not supported", amountTyp)
+	}
+	return newAmountSafe(m, d)
+}
+
+// bsonValueLen returns the length of a BSON value of the given type
+// at the beginning of the data.
+// The byte order of the input data must be little-endian.
+func bsonValueLen(typ byte, data []byte) (int, error) {
+	var l int
+	switch typ {
+	case 6, 10, 127, 255: // undefined, null, max key, min key
+		l = 0
+	case 16:
+		l = 4
+	case 1, 9, 17, 18:
+		l = 8
+	case 7:
+		l = 12
+	case 19:
+		l = 16
+	case 8:
+		l = 1
+	case 11: // regular expression: pattern and options as C strings
+		for range 2 {
+			i := bytes.IndexByte(data[l:], 0)
+			if i < 0 {
+				return 0, fmt.Errorf("invalid null terminator")
+			}
+			l += i + 1
+		}
+	case 2, 3, 4, 5, 12, 13, 14, 15:
+		if len(data) < 4 {
+			return 0, fmt.Errorf("invalid data length %v", len(data))
+		}
+		u := uint32(data[0])
+		u |= uint32(data[1]) << 8
+		u |= uint32(data[2]) << 16
+		u |= uint32(data[3]) << 24
+		l = int(u) //nolint:gosec
+		switch typ {
+		case 2, 13, 14: // string, JavaScript code, symbol
+			l += 4
+		case 5: // binary data with a subtype
+			l += 4 + 1
+		case 12: // DBPointer: string and ObjectId
+			l += 4 + 12
+		}
+	default:
+		return 0, fmt.Errorf("BSON type %d is not supported", typ)
+	}
+	if l < 0 || len(data) < l {
+		return 0, fmt.Errorf("invalid data length %v", len(data))
+	}
+	return l, nil
+}
+
 // Zero returns an amount with a value of 0, having the same currency and scale
 // as amount a.
 // See also methods [Amount.One], [Amount.ULP].
@@ -574,6 +1381,7 @@ func (a Amount) WithinOne() bool {
 }
 
 // Neg returns an amount with the opposite sign.
+// Negating zero returns zero, since amounts do not have a negative zero.
 func (a Amount) Neg() Amount {
 	return newAmountUnsafe(a.Curr(), a.Decimal().Neg())
 }
@@ -585,7 +1393,7 @@ func (a Amount) Abs() Amount {
 
 // CopySign returns an amount with the same sign as amount b.
 // The currency of amount b is ignored.
-// CopySign treates 0 as positive.
+// CopySign treats 0 as positive.
 // See also method [Amount.Sign].
 func (a Amount) CopySign(b Amount) Amount {
 	d, e := a.Decimal(), b.Decimal()
@@ -627,6 +1435,26 @@ func (a Amount) IsZero() bool {
 	return a.Decimal().IsZero()
 }
 
+// EnsurePos returns an error if the amount is not positive.
+// It is useful for validating user input, such as payment amounts.
+// See also methods [Amount.IsPos], [Amount.EnsureNonNeg].
+func (a Amount) EnsurePos() error {
+	if !a.IsPos() {
+		return fmt.Errorf("amount must be positive, got %v", a)
+	}
+	return nil
+}
+
+// EnsureNonNeg returns an error if the amount is negative.
+// It is useful for validating user input, such as account balances.
+// See also methods [Amount.IsNeg], [Amount.EnsurePos].
+func (a Amount) EnsureNonNeg() error {
+	if a.IsNeg() {
+		return fmt.Errorf("amount must be non-negative, got %v", a)
+	}
+	return nil
+}
+
 // Add returns the (possibly rounded) sum of amounts a and b.
 //
 // Add returns an error if:
@@ -644,12 +1472,12 @@ func (a Amount) Add(b Amount) (Amount, error) {
 
 func (a Amount) add(b Amount) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.AddExact(e, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
@@ -684,14 +1512,37 @@ func (a Amount) SubAbs(b Amount) (Amount, error) {
 	return c.Abs(), nil
 }
 
+// SubOverdraw returns the (possibly rounded) difference between amounts a and b,
+// together with the overdraw, the absolute value of the difference if it is
+// negative, or zero otherwise.
+// This method is useful for detecting overdrafts when applying a payment b
+// to a balance a.
+// See also method [Amount.Sub].
+//
+// SubOverdraw returns an error if:
+//   - amounts are denominated in different currencies;
+//   - the integer part of the result has more than ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//     For example, when currency is US Dollars, SubOverdraw will return an error if the integer
+//     part of the result has more than 17 digits (19 - 2 = 17).
+func (a Amount) SubOverdraw(b Amount) (c, overdraw Amount, err error) {
+	c, err = a.sub(b)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("computing [%v - %v]: %w", a, b, err)
+	}
+	if c.IsNeg() {
+		return c, c.Abs(), nil
+	}
+	return c, c.Zero(), nil
+}
+
 func (a Amount) sub(b Amount) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.SubExact(e, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
@@ -726,12 +1577,12 @@ func (a Amount) SubMul(b Amount, e decimal.Decimal) (Amount, error) {
 
 func (a Amount) subMul(b Amount, f decimal.Decimal) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.SubMulExact(e, f, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
@@ -758,12 +1609,12 @@ func (a Amount) AddMul(b Amount, e decimal.Decimal) (Amount, error) {
 
 func (a Amount) addMul(b Amount, f decimal.Decimal) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.AddMulExact(e, f, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
@@ -786,11 +1637,88 @@ func (a Amount) mul(e decimal.Decimal) (Amount, error) {
 	m, d := a.Curr(), a.Decimal()
 	d, err := d.MulExact(e, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
 
+// MulRound returns the product of amount a and factor e rounded to the scale
+// of the currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also methods [Amount.Mul], [Amount.RoundWith].
+//
+// MulRound returns an error if the integer part of the result has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+// For example, when currency is US Dollars, MulRound will return an error if the integer
+// part of the result has more than 17 digits (19 - 2 = 17).
+func (a Amount) MulRound(e decimal.Decimal, mode ...RoundingMode) (Amount, error) {
+	r := roundingMode(mode)
+	c, err := a.mul(e)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing [%v * %v] rounded %v: %w", a, e, r, err)
+	}
+	return c.RoundWith(c.Curr().Scale(), r), nil
+}
+
+// Percent returns the given percentage of amount a, rounded to the scale of
+// the currency using the specified rounding mode, together with the rest of
+// the amount.
+// The part and the rest always sum up to the original amount, so fees and
+// taxes computed this way never lose or create minor units.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also methods [Amount.Bps] and [Amount.MulRound].
+//
+// Percent returns an error if the integer part of the result has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+// For example, when currency is US Dollars, Percent will return an error if the integer
+// part of the result has more than 17 digits (19 - 2 = 17).
+func (a Amount) Percent(p decimal.Decimal, mode ...RoundingMode) (part, rest Amount, err error) {
+	r := roundingMode(mode)
+	part, rest, err = a.portion(p, decimal.Hundred, r)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("computing %v%% of [%v] rounded %v: %w", p, a, r, err)
+	}
+	return part, rest, nil
+}
+
+// Bps is like [Amount.Percent] but takes the portion in basis points,
+// where 1 basis point is 0.01%.
+func (a Amount) Bps(n int64, mode ...RoundingMode) (part, rest Amount, err error) {
+	r := roundingMode(mode)
+	part, rest, err = a.bps(n, r)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("computing %v bps of [%v] rounded %v: %w", n, a, r, err)
+	}
+	return part, rest, nil
+}
+
+func (a Amount) bps(n int64, r RoundingMode) (part, rest Amount, err error) {
+	e, err := decimal.New(n, 0)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return a.portion(e, decimal.MustNew(10_000, 0), r)
+}
+
+// portion returns the share e / base of the amount rounded to the scale of
+// the currency, and the rest of the amount.
+func (a Amount) portion(e, base decimal.Decimal, r RoundingMode) (part, rest Amount, err error) {
+	f, err := e.Quo(base)
+	if err != nil {
+		return Amount{}, Amount{}, decimalErr(err)
+	}
+	part, err = a.mul(f)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	part = part.RoundWith(part.Curr().Scale(), r)
+	rest, err = a.sub(part)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return part, rest, nil
+}
+
 // SubQuo returns the (possibly rounded) fused quotient-subtraction of amounts a, b, and factor e.
 // It computes a - b / e with at least double precision during intermediate rounding.
 // This method is useful for improving the accuracy and performance of algorithms
@@ -812,12 +1740,12 @@ func (a Amount) SubQuo(b Amount, e decimal.Decimal) (Amount, error) {
 
 func (a Amount) subQuo(b Amount, f decimal.Decimal) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.SubQuoExact(e, f, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
@@ -843,12 +1771,12 @@ func (a Amount) AddQuo(b Amount, e decimal.Decimal) (Amount, error) {
 
 func (a Amount) addQuo(b Amount, f decimal.Decimal) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.AddQuoExact(e, f, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
@@ -873,11 +1801,30 @@ func (a Amount) quo(e decimal.Decimal) (Amount, error) {
 	m, d := a.Curr(), a.Decimal()
 	d, err := d.QuoExact(e, m.Scale())
 	if err != nil {
-		return Amount{}, err
+		return Amount{}, decimalErr(err)
 	}
 	return newAmountSafe(m, d)
 }
 
+// QuoRound returns the quotient of amount a and divisor e rounded to the scale
+// of the currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also methods [Amount.Quo], [Amount.RoundWith].
+//
+// QuoRound returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//     For example, when currency is US Dollars, QuoRound will return an error if the integer
+//     part of the result has more than 17 digits (19 - 2 = 17).
+func (a Amount) QuoRound(e decimal.Decimal, mode ...RoundingMode) (Amount, error) {
+	r := roundingMode(mode)
+	c, err := a.quo(e)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing [%v / %v] rounded %v: %w", a, e, r, err)
+	}
+	return c.RoundWith(c.Curr().Scale(), r), nil
+}
+
 // QuoRem returns the quotient q and remainder r of amount a and divisor e
 // such that a = e * q + r, where q has scale equal to the scale of its currency
 // and the sign of the reminder r is the same as the sign of the dividend d.
@@ -898,7 +1845,7 @@ func (a Amount) quoRem(e decimal.Decimal) (q, r Amount, err error) {
 	// Quotient
 	q, err = a.Quo(e)
 	if err != nil {
-		return Amount{}, Amount{}, err
+		return Amount{}, Amount{}, decimalErr(err)
 	}
 
 	// T-Division
@@ -907,7 +1854,7 @@ func (a Amount) quoRem(e decimal.Decimal) (q, r Amount, err error) {
 	// Reminder
 	r, err = q.Mul(e)
 	if err != nil {
-		return Amount{}, Amount{}, err
+		return Amount{}, Amount{}, decimalErr(err)
 	}
 	r, err = a.Sub(r)
 	if err != nil {
@@ -933,11 +1880,105 @@ func (a Amount) Rat(b Amount) (decimal.Decimal, error) {
 	return d, nil
 }
 
+// DivMod returns the quotient q and remainder r of amount a and integer n
+// such that a = n * q + r, where q has scale equal to the scale of its currency
+// and the sign of the remainder r is the same as the sign of the amount a.
+// Unlike [Amount.Quo], DivMod keeps the remainder, including any digits
+// beyond the scale of the currency, so that it can be distributed by
+// the caller.
+// See also methods [Amount.QuoRem] and [Amount.Split].
+//
+// DivMod returns an error if n is 0.
+func (a Amount) DivMod(n int64) (q, r Amount, err error) {
+	if n == 0 {
+		return Amount{}, Amount{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", a, n, a, n, errDivisionByZero)
+	}
+	q, r, err = a.quoRem(decimal.MustNew(n, 0))
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", a, n, a, n, err)
+	}
+	return q, r, nil
+}
+
+// errDivisionByZero is returned when the divisor of an amount is 0.
+var errDivisionByZero = errors.New("division by zero")
+
+// Ratio returns the exact ratio between amounts a and b as a fraction
+// num / den in lowest terms, where den is positive.
+// Unlike [Amount.Rat], Ratio never rounds, so that it can be used to
+// distribute amounts without losing track of residuals.
+// For example, the ratio between "USD 1.00" and "USD 3.00" is 1 / 3.
+// See also methods [Amount.Rat] and [Amount.DivMod].
+//
+// Ratio returns an error if:
+//   - the divisor is 0;
+//   - the numerator or denominator does not fit in int64.
+func (a Amount) Ratio(b Amount) (num, den int64, err error) {
+	num, den, err = a.ratio(b)
+	if err != nil {
+		return 0, 0, fmt.Errorf("computing [%v / %v]: %w", a, b, err)
+	}
+	return num, den, nil
+}
+
+func (a Amount) ratio(b Amount) (num, den int64, err error) {
+	d, e := a.Decimal(), b.Decimal()
+	if e.IsZero() {
+		return 0, 0, errDivisionByZero
+	}
+	x, y := d.Coef(), e.Coef()
+	g := gcd(x, y)
+	x, y = x/g, y/g
+
+	// Aligning scales: x / 10^dscale divided by y / 10^escale
+	switch ds, es := d.Scale(), e.Scale(); {
+	case es > ds:
+		x, err = mulPow10(x, &y, es-ds)
+	case ds > es:
+		y, err = mulPow10(y, &x, ds-es)
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if x > math.MaxInt64 || y > math.MaxInt64 {
+		return 0, 0, ErrOverflow
+	}
+	num, den = int64(x), int64(y) //nolint:gosec
+	if d.Sign() != e.Sign() {
+		num = -num
+	}
+	return num, den, nil
+}
+
+// mulPow10 multiplies x by 10^n, keeping x and y in lowest terms, and
+// returns an error if the product does not fit in uint64.
+func mulPow10(x uint64, y *uint64, n int) (uint64, error) {
+	for range n {
+		f := uint64(10)
+		g := gcd(f, *y)
+		f, *y = f/g, *y/g
+		hi, lo := bits.Mul64(x, f)
+		if hi != 0 {
+			return 0, ErrOverflow
+		}
+		x = lo
+	}
+	return x, nil
+}
+
+// gcd returns the greatest common divisor of x and y.
+func gcd(x, y uint64) uint64 {
+	for y != 0 {
+		x, y = y, x%y
+	}
+	return x
+}
+
 // Split returns a slice of amounts that sum up to the original amount,
 // ensuring the parts are as equal as possible.
 // If the original amount cannot be divided equally among the specified number
 // of parts, the remainder is distributed among the first parts of the slice.
-// See also methods [Amount.Quo], [Amount.QuoRem], and [Amount.Rat].
+// See also methods [Amount.Allocate], [Amount.Quo], [Amount.QuoRem], and [Amount.Rat].
 //
 // Split returns an error if the number of parts is not a positive integer.
 func (a Amount) Split(parts int) ([]Amount, error) {
@@ -961,14 +2002,14 @@ func (a Amount) split(parts int) ([]Amount, error) {
 	// Quotient
 	quo, err := a.Quo(par)
 	if err != nil {
-		return nil, err
+		return nil, decimalErr(err)
 	}
 	quo = quo.Trunc(a.Scale())
 
 	// Reminder
 	rem, err := quo.Mul(par)
 	if err != nil {
-		return nil, err
+		return nil, decimalErr(err)
 	}
 	rem, err = a.Sub(rem)
 	if err != nil {
@@ -994,6 +2035,172 @@ func (a Amount) split(parts int) ([]Amount, error) {
 	return res, nil
 }
 
+// Allocate returns a slice of amounts that sum up to the original amount,
+// ensuring the parts are proportional to the given ratios.
+// Each part is truncated to the scale of the original amount, and the remainder
+// is distributed one unit in the last place at a time using the
+// [largest remainder method]: parts whose exact proportional shares lost
+// the most to truncation receive the remainder first.
+// Ties are broken in favor of the parts that come first in the slice.
+// Parts with zero ratios are always zero.
+// See also methods [Amount.AllocateSeeded] and [Amount.Split].
+//
+// Allocate returns an error if:
+//   - no ratios are given;
+//   - any of the ratios is negative;
+//   - the sum of ratios is zero or does not fit into uint64.
+//
+// [largest remainder method]: https://en.wikipedia.org/wiki/Largest_remainder_method
+func (a Amount) Allocate(ratios ...int) ([]Amount, error) {
+	less := func(i, j int, rems []uint64) bool {
+		return rems[i] > rems[j]
+	}
+	r, err := a.allocate(ratios, less)
+	if err != nil {
+		return nil, fmt.Errorf("allocating %v in ratios %v: %w", a, ratios, err)
+	}
+	return r, nil
+}
+
+// AllocateSeeded returns a slice of amounts that sum up to the original amount,
+// ensuring the parts are proportional to the given ratios.
+// Each part is truncated to the scale of the original amount, and the remainder
+// is distributed one unit in the last place at a time among the parts with
+// non-zero ratios.
+// The order in which the parts receive the remainder is derived from the hash
+// of the seed, so that the same seed, for example an invoice ID, always yields
+// the same allocation, while different seeds spread the remainder evenly
+// across the parts.
+// See also methods [Amount.Allocate] and [Amount.Split].
+//
+// AllocateSeeded returns an error if:
+//   - no ratios are given;
+//   - any of the ratios is negative;
+//   - the sum of ratios is zero or does not fit into uint64.
+func (a Amount) AllocateSeeded(seed string, ratios ...int) ([]Amount, error) {
+	less := func(i, j int, _ []uint64) bool {
+		return seededKey(seed, i) < seededKey(seed, j)
+	}
+	r, err := a.allocate(ratios, less)
+	if err != nil {
+		return nil, fmt.Errorf("allocating %v with seed %q in ratios %v: %w", a, seed, ratios, err)
+	}
+	return r, nil
+}
+
+// seededKey returns the [FNV-1a] hash of the seed and the part index.
+//
+// [FNV-1a]: https://en.wikipedia.org/wiki/Fowler%E2%80%93Noll%E2%80%93Vo_hash_function
+func seededKey(seed string, i int) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed)) // hash.Hash.Write never returns an error
+	_, _ = h.Write([]byte{0, byte(i), byte(i >> 8), byte(i >> 16), byte(i >> 24)})
+	return h.Sum64()
+}
+
+// allocate distributes the amount among the parts in proportion to the ratios.
+// The less function defines the order in which the parts with non-zero ratios
+// receive the remainder, it is given the indices of the parts and the
+// remainders of their exact proportional shares.
+func (a Amount) allocate(ratios []int, less func(i, j int, rems []uint64) bool) ([]Amount, error) {
+	// Ratios
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("no ratios")
+	}
+	var total uint64
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, fmt.Errorf("ratio must be non-negative")
+		}
+		t := total + uint64(r)
+		if t < total {
+			return nil, fmt.Errorf("sum of ratios overflows")
+		}
+		total = t
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("sum of ratios must be positive")
+	}
+
+	// Shares
+	m, d := a.Curr(), a.Decimal()
+	coef := d.Coef()
+	shares := make([]uint64, len(ratios))
+	rems := make([]uint64, len(ratios))
+	var sum uint64
+	for i, r := range ratios {
+		hi, lo := bits.Mul64(coef, uint64(r))
+		shares[i], rems[i] = bits.Div64(hi, lo, total)
+		sum += shares[i]
+	}
+
+	// Reminder distribution
+	idx := make([]int, 0, len(ratios))
+	for i, r := range ratios {
+		if r > 0 {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(x, y int) bool {
+		return less(idx[x], idx[y], rems)
+	})
+	for i := range coef - sum {
+		shares[idx[i]]++
+	}
+
+	res := make([]Amount, len(ratios))
+	for i, s := range shares {
+		e, err := newDecimalFromCoef(d.IsNeg(), s, d.Scale())
+		if err != nil {
+			return nil, err
+		}
+		res[i] = newAmountUnsafe(m, e)
+	}
+	return res, nil
+}
+
+// pow10 contains powers of ten that fit into uint64.
+var pow10 = [...]uint64{
+	1,
+	10,
+	100,
+	1_000,
+	10_000,
+	100_000,
+	1_000_000,
+	10_000_000,
+	100_000_000,
+	1_000_000_000,
+	10_000_000_000,
+	100_000_000_000,
+	1_000_000_000_000,
+	10_000_000_000_000,
+	100_000_000_000_000,
+	1_000_000_000_000_000,
+	10_000_000_000_000_000,
+	100_000_000_000_000_000,
+	1_000_000_000_000_000_000,
+	10_000_000_000_000_000_000,
+}
+
+// newDecimalFromCoef returns a decimal equal to (-1)^neg * coef / 10^scale.
+func newDecimalFromCoef(neg bool, coef uint64, scale int) (decimal.Decimal, error) {
+	whole, frac := coef/pow10[scale], coef%pow10[scale]
+	if whole > math.MaxInt64 {
+		return decimal.Decimal{}, ErrOverflow
+	}
+	//nolint:gosec
+	w, f := int64(whole), int64(frac)
+	if neg {
+		w, f = -w, -f
+	}
+	d, err := decimal.NewFromInt64(w, f, scale)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return d.Pad(scale), nil
+}
+
 // Ceil returns an amount rounded up to the specified number of digits after
 // the decimal point using [rounding toward positive infinity].
 // If the given scale is negative, it is redefined to zero.
@@ -1078,6 +2285,60 @@ func (a Amount) RoundToCurr() Amount {
 	return a.Round(a.Curr().Scale())
 }
 
+// RoundToCash returns an amount rounded to a multiple of the smallest unit
+// of its currency that can be paid in cash, such as 0.05 for Swiss Francs.
+// This method is useful for rounding totals at the point of sale.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also methods [Currency.CashUnit], [Amount.RoundToUnit].
+//
+// RoundToCash returns an error if the integer part of the result has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (a Amount) RoundToCash(mode ...RoundingMode) (Amount, error) {
+	return a.RoundToUnit(a.Curr().CashUnit(), mode...)
+}
+
+// RoundToUnit returns an amount rounded to a multiple of the given unit,
+// such as the smallest coin accepted by a vending machine.
+// The result has at least as many digits after the decimal point as
+// the currency.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also method [Amount.RoundToCash].
+//
+// RoundToUnit returns an error if:
+//   - the unit is not positive;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (a Amount) RoundToUnit(unit decimal.Decimal, mode ...RoundingMode) (Amount, error) {
+	r := roundingMode(mode)
+	b, err := a.roundToUnit(unit, r)
+	if err != nil {
+		return Amount{}, fmt.Errorf("rounding [%v] to a multiple of %v %v: %w", a, unit, r, err)
+	}
+	return b, nil
+}
+
+func (a Amount) roundToUnit(u decimal.Decimal, r RoundingMode) (Amount, error) {
+	if !u.IsPos() {
+		return Amount{}, fmt.Errorf("unit must be positive")
+	}
+	d, err := r.roundToMultiple(a.Decimal(), u)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmountSafe(a.Curr(), d)
+}
+
+// RoundWith returns an amount rounded to the specified number of digits after
+// the decimal point using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// If the given scale is negative, it is redefined to zero.
+// See also methods [Amount.Round], [Amount.Ceil], [Amount.Floor], [Amount.Trunc].
+func (a Amount) RoundWith(scale int, mode ...RoundingMode) Amount {
+	m, d := a.Curr(), a.Decimal()
+	d = roundingMode(mode).round(d, scale).Pad(m.Scale())
+	return newAmountUnsafe(m, d)
+}
+
 // Quantize returns an amount rescaled to the same scale as amount b.
 // The currency and the sign of amount b are ignored.
 // See also methods [Amount.Scale], [Amount.SameScale], [Amount.Rescale].
@@ -1111,6 +2372,115 @@ func (a Amount) TrimToCurr() Amount {
 	return a.Trim(a.Curr().Scale())
 }
 
+// AmountKey is a comparable representation of an amount that can be used
+// as a map key, for example in deduplication caches.
+// Amounts that are equal according to [Amount.Equal], such as "USD 1.0" and
+// "USD 1.00", have equal keys.
+// The zero value represents the amount "XXX 0".
+// See also method [Amount.Key].
+type AmountKey struct {
+	curr  Currency
+	value decimal.Decimal // trimmed, so that equal amounts have equal values
+}
+
+// Key returns a comparable representation of the amount that can be used as
+// a map key, without the allocations of [Amount.String].
+// Unlike Amount itself, whose values can be compared with == only when they
+// have the same scale, keys of equal amounts are always equal.
+// See also methods [Amount.Hash], [AmountKey.Amount].
+func (a Amount) Key() AmountKey {
+	d := a.Decimal()
+	return AmountKey{curr: a.Curr(), value: d.Trim(0)}
+}
+
+// Amount returns the amount represented by the key, padded to the scale
+// of its currency.
+func (k AmountKey) Amount() Amount {
+	return newAmountUnsafe(k.curr, k.value.Pad(k.curr.Scale()))
+}
+
+// canonicalLen is the length of the canonical encoding of an amount.
+const canonicalLen = 3 + 1 + 8 + 1
+
+// AppendCanonical appends the canonical encoding of the amount to the byte
+// slice and returns the extended slice.
+// The encoding has a fixed length of 13 bytes:
+//
+//	3 bytes: currency code, for example "USD"
+//	1 byte:  sign, 0 for zero and positive amounts, 1 for negative amounts
+//	8 bytes: coefficient with trailing zeros removed, big-endian
+//	1 byte:  scale of the coefficient
+//
+// Equal amounts have identical encodings regardless of their scales and of
+// the order in which currencies were registered, so the encoding is suitable
+// for content-addressed storage and for hashing across processes.
+// See also methods [Amount.Hash], [Amount.Key].
+func (a Amount) AppendCanonical(data []byte) []byte {
+	k := a.Key()
+	code := k.curr.Code()
+	if code == "" {
+		code = "???"
+	}
+	data = append(data, code...)
+	if k.value.IsNeg() {
+		data = append(data, 1)
+	} else {
+		data = append(data, 0)
+	}
+	data = binary.BigEndian.AppendUint64(data, k.value.Coef())
+	return append(data, byte(k.value.Scale())) //nolint:gosec
+}
+
+// Hash returns the 64-bit [FNV-1a] hash of the canonical encoding of
+// the amount.
+// Equal amounts have equal hashes, and the hash is the same in every
+// process and on every platform.
+// Hash does not allocate memory.
+// See also method [Amount.AppendCanonical].
+//
+// [FNV-1a]: https://en.wikipedia.org/wiki/Fowler%E2%80%93Noll%E2%80%93Vo_hash_function
+func (a Amount) Hash() uint64 {
+	var buf [canonicalLen]byte
+	h := fnv.New64a()
+	_, _ = h.Write(a.AppendCanonical(buf[:0]))
+	return h.Sum64()
+}
+
+// Generate implements the [quick.Generator] interface, so that amounts can
+// be used as arguments of functions checked by [quick.Check].
+// Generate returns an amount in a random currency, chosen from the currencies
+// defined by the ISO 4217 standard and by [RegisterCurr], with as many digits
+// after the decimal point as the scale of the currency.
+// The number of digits is chosen uniformly between 1 and the smaller of size
+// and 15, and then every digit and the sign are chosen uniformly.
+// See package moneytest for generators that take a [rand/v2.Rand].
+//
+// [quick.Generator]: https://pkg.go.dev/testing/quick#Generator
+// [quick.Check]: https://pkg.go.dev/testing/quick#Check
+// [rand/v2.Rand]: https://pkg.go.dev/math/rand/v2#Rand
+func (Amount) Generate(r *rand.Rand, size int) reflect.Value {
+	currs := AllCurrs()[1:] // XXX always comes first
+	m := currs[r.Intn(len(currs))]
+	prec := 1 + r.Intn(max(min(size, generateMaxPrec), 1))
+	var coef int64
+	for range prec {
+		coef = coef*10 + r.Int63n(10)
+	}
+	if r.Intn(2) == 0 {
+		coef = -coef
+	}
+	d, err := decimal.New(coef, m.Scale())
+	if err != nil {
+		panic(err) // generateMaxPrec never exceeds the precision of decimals
+	}
+	return reflect.ValueOf(newAmountUnsafe(m, d))
+}
+
+// generateMaxPrec is the maximum number of digits in amounts returned by
+// Amount.Generate, so that thousands of them can be added together without
+// an overflow.
+const generateMaxPrec = decimal.MaxPrec - 4
+
 // SameCurr returns true if amounts are denominated in the same currency.
 // See also method [Amount.Curr].
 func (a Amount) SameCurr(b Amount) bool {
@@ -1217,7 +2587,7 @@ func (a Amount) Clamp(min, max Amount) (Amount, error) {
 // CmpTotal returns an error if amounts are denominated in different currencies.
 func (a Amount) CmpTotal(b Amount) (int, error) {
 	if !a.SameCurr(b) {
-		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, errCurrencyMismatch)
+		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, ErrCurrencyMismatch)
 	}
 	d, e := a.Decimal(), b.Decimal()
 	return d.CmpTotal(e), nil
@@ -1234,7 +2604,7 @@ func (a Amount) CmpTotal(b Amount) (int, error) {
 // CmpAbs returns an error if amounts are denominated in different currencies.
 func (a Amount) CmpAbs(b Amount) (int, error) {
 	if !a.SameCurr(b) {
-		return 0, fmt.Errorf("comparing [abs(%v)] and [abs(%v)]: %w", a, b, errCurrencyMismatch)
+		return 0, fmt.Errorf("comparing [abs(%v)] and [abs(%v)]: %w", a, b, ErrCurrencyMismatch)
 	}
 	d, e := a.Decimal(), b.Decimal()
 	return d.CmpAbs(e), nil
@@ -1283,8 +2653,171 @@ func (a Amount) Less(b Amount) (bool, error) {
 // Cmp returns an error if amounts are denominated in different currencies.
 func (a Amount) Cmp(b Amount) (int, error) {
 	if !a.SameCurr(b) {
-		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, errCurrencyMismatch)
+		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, ErrCurrencyMismatch)
 	}
 	d, e := a.Decimal(), b.Decimal()
 	return d.Cmp(e), nil
 }
+
+// NullAmount represents an amount that can be null.
+// Its zero value is null.
+// NullAmount is not thread-safe.
+type NullAmount struct {
+	Amount Amount
+	Valid  bool
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also method [Amount.Scan].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (n *NullAmount) Scan(value any) error {
+	if value == nil {
+		n.Amount = Amount{}
+		n.Valid = false
+		return nil
+	}
+	if err := n.Amount.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the [driver.Valuer] interface.
+// See also method [Amount.Value].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (n NullAmount) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Amount.Value()
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// See also method [Amount.UnmarshalJSON].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (n *NullAmount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Amount = Amount{}
+		n.Valid = false
+		return nil
+	}
+	if err := n.Amount.UnmarshalJSON(data); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// See also method [Amount.MarshalJSON].
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (n NullAmount) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Amount.MarshalJSON()
+}
+
+// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
+// See also method [Amount.UnmarshalBSONValue].
+//
+// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
+func (n *NullAmount) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == 10 {
+		n.Amount = Amount{}
+		n.Valid = false
+		return nil
+	}
+	if err := n.Amount.UnmarshalBSONValue(typ, data); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalBSONValue implements the [v2/bson.ValueMarshaler] interface.
+// See also method [Amount.MarshalBSONValue].
+//
+// [v2/bson.ValueMarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueMarshaler
+func (n NullAmount) MarshalBSONValue() (typ byte, data []byte, err error) {
+	if !n.Valid {
+		return 10, nil, nil
+	}
+	return n.Amount.MarshalBSONValue()
+}
+
+// TextAmount represents an amount that is encoded in JSON as a string
+// in the "USD 12.34" format, rather than as an object.
+type TextAmount struct {
+	Amount Amount
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// See also method [Amount.UnmarshalJSON].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (t *TextAmount) UnmarshalJSON(data []byte) error {
+	return t.Amount.UnmarshalJSON(data)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// MarshalJSON always returns a string in the "USD 12.34" format.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (t TextAmount) MarshalJSON() ([]byte, error) {
+	text := make([]byte, 0, 32)
+	text = append(text, '"')
+	text = t.Amount.append(text)
+	text = append(text, '"')
+	return text, nil
+}
+
+// MinorUnits represents an amount stored in two columns: a currency code and
+// an integer number of minor units of the currency (e.g. cents, pennies, fens).
+// Both fields can be passed directly to [sql.DB.Exec] and [sql.Rows.Scan].
+// In JSON, minor units are represented as an object, for example
+// {"currency":"USD","minor_units":1234}.
+// Unlike [Amount.MinorUnits], conversions never round the amount silently.
+//
+// [sql.DB.Exec]: https://pkg.go.dev/database/sql#DB.Exec
+// [sql.Rows.Scan]: https://pkg.go.dev/database/sql#Rows.Scan
+type MinorUnits struct {
+	Curr  Currency `json:"currency"`
+	Units int64    `json:"minor_units"`
+}
+
+// NewMinorUnits converts an amount to minor units of its currency.
+// See also method [MinorUnits.Amount].
+//
+// NewMinorUnits returns an error if:
+//   - the amount has non-zero digits beyond the scale of its currency;
+//   - the number of minor units cannot be represented as an int64.
+func NewMinorUnits(a Amount) (MinorUnits, error) {
+	if a.TrimToCurr().Scale() > a.Curr().Scale() {
+		return MinorUnits{}, fmt.Errorf("converting %v to minor units: amount has more than %v digits after the decimal point", a, a.Curr().Scale())
+	}
+	units, ok := a.MinorUnits()
+	if !ok {
+		return MinorUnits{}, fmt.Errorf("converting %v to minor units: %w", a, ErrOverflow)
+	}
+	return MinorUnits{Curr: a.Curr(), Units: units}, nil
+}
+
+// Amount converts minor units to an amount.
+// The result has the same scale as the currency.
+// See also constructor [NewMinorUnits].
+func (m MinorUnits) Amount() (Amount, error) {
+	d, err := decimal.New(m.Units, m.Curr.Scale())
+	if err != nil {
+		return Amount{}, fmt.Errorf("converting %v %v minor units to amount: %w", m.Units, m.Curr, err)
+	}
+	return newAmountSafe(m.Curr, d)
+}
@@ -1,13 +1,21 @@
 package money
 
 import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"reflect"
 	"testing"
 	"unsafe"
 
 	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
 )
 
 func TestAmount_ZeroValue(t *testing.T) {
@@ -37,6 +45,40 @@ func TestAmount_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement fmt.Formatter", i)
 	}
+	_, ok = i.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", i)
+	}
+	_, ok = i.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", i)
+	}
+	_, ok = i.(encoding.TextMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextMarshaler", i)
+	}
+	_, ok = i.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", i)
+	}
+
+	i = &Amount{}
+	_, ok = i.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", i)
+	}
+	_, ok = i.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", i)
+	}
+	_, ok = i.(encoding.TextUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextUnmarshaler", i)
+	}
+	_, ok = i.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", i)
+	}
 }
 
 func TestNewAmount(t *testing.T) {
@@ -193,6 +235,126 @@ func TestNewAmountFromInt64(t *testing.T) {
 	})
 }
 
+func TestNewAmountFromProto(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr  string
+			units int64
+			nanos int32
+			want  string
+		}{
+			{"USD", 0, 0, "0.00"},
+			{"USD", 12, 340_000_000, "12.34"},
+			{"USD", -12, -340_000_000, "-12.34"},
+			{"USD", 0, -10_000_000, "-0.01"},
+			{"USD", -1, 0, "-1.00"},
+			{"JPY", 1234, 0, "1234"},
+			{"OMR", 1, 1_000_000, "1.001"},
+			{"USD", 99_999_999_999_999_999, 990_000_000, "99999999999999999.99"},
+		}
+		for _, tt := range tests {
+			got, err := NewAmountFromProto(tt.curr, tt.units, tt.nanos)
+			if err != nil {
+				t.Errorf("NewAmountFromProto(%q, %v, %v) failed: %v", tt.curr, tt.units, tt.nanos, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("NewAmountFromProto(%q, %v, %v) = %q, want %q", tt.curr, tt.units, tt.nanos, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr  string
+			units int64
+			nanos int32
+		}{
+			"currency 1": {"UUU", 1, 0},
+			"range 1":    {"USD", 0, 1_000_000_000},
+			"range 2":    {"USD", 0, -1_000_000_000},
+			"sign 1":     {"USD", 1, -10_000_000},
+			"sign 2":     {"USD", -1, 10_000_000},
+			"scale 1":    {"USD", 12, 345_000_000},
+			"scale 2":    {"USD", 0, 1},
+			"scale 3":    {"JPY", 1, 500_000_000},
+			"overflow 1": {"USD", math.MaxInt64, 0},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewAmountFromProto(tt.curr, tt.units, tt.nanos)
+				if err == nil {
+					t.Errorf("NewAmountFromProto(%q, %v, %v) did not fail", tt.curr, tt.units, tt.nanos)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_Proto(t *testing.T) {
+	qxb := MustRegisterCurr("QXB", "", 12)
+	t.Cleanup(func() { unregister(qxb) })
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d      string
+			wantUnits int64
+			wantNanos int32
+		}{
+			{"USD", "0", 0, 0},
+			{"USD", "12.34", 12, 340_000_000},
+			{"USD", "-12.34", -12, -340_000_000},
+			{"USD", "-0.01", 0, -10_000_000},
+			{"USD", "12.3400", 12, 340_000_000},
+			{"JPY", "1234", 1234, 0},
+			{"OMR", "1.001", 1, 1_000_000},
+			{"JPY", "9223372036854775807", math.MaxInt64, 0},
+			{"QXB", "1.000000001000", 1, 1},
+			{"QXB", "-1.5", -1, -500_000_000},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			gotCurr, gotUnits, gotNanos, err := a.Proto()
+			if err != nil {
+				t.Errorf("%q.Proto() failed: %v", a, err)
+				continue
+			}
+			if gotCurr != tt.m || gotUnits != tt.wantUnits || gotNanos != tt.wantNanos {
+				t.Errorf("%q.Proto() = (%q, %v, %v), want (%q, %v, %v)", a, gotCurr, gotUnits, gotNanos, tt.m, tt.wantUnits, tt.wantNanos)
+				continue
+			}
+			b, err := NewAmountFromProto(gotCurr, gotUnits, gotNanos)
+			if err != nil {
+				t.Errorf("NewAmountFromProto(%q, %v, %v) failed: %v", gotCurr, gotUnits, gotNanos, err)
+				continue
+			}
+			if ok, _ := b.Equal(a); !ok {
+				t.Errorf("NewAmountFromProto(%q, %v, %v) = %q, want %q", gotCurr, gotUnits, gotNanos, b, a)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			m, d string
+		}{
+			{"USD", "12.345"},
+			{"JPY", "0.5"},
+			{"JPY", "9223372036854775808"},
+			{"QXB", "1.000000000001"},
+			{"QXB", "-0.0000000005"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			_, _, _, err := a.Proto()
+			if err == nil {
+				t.Errorf("%q.Proto() did not fail", a)
+			}
+		}
+	})
+}
+
 func TestNewAmountFromMinorUnits(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -319,6 +481,59 @@ func TestNewAmountFromFloat64(t *testing.T) {
 	})
 }
 
+func TestNewAmountFromFloat64Round(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr string
+			f    float64
+			mode RoundingMode
+			want string
+		}{
+			{"USD", 0, HalfEven, "0.00"},
+			{"USD", 0.30000000000000004, HalfEven, "0.30"},
+			{"USD", 1.005, HalfEven, "1.00"},
+			{"USD", 1.015, HalfEven, "1.02"},
+			{"USD", 1.015, Floor, "1.01"},
+			{"USD", 1.011, Ceiling, "1.02"},
+			{"USD", -1.011, Ceiling, "-1.01"},
+			{"USD", 2.5, HalfEven, "2.50"},
+			{"JPY", 2.5, HalfEven, "2"},
+			{"JPY", 2.5, HalfUp, "3"},
+			{"OMR", 0.0005, HalfUp, "0.001"},
+		}
+		for _, tt := range tests {
+			got, err := NewAmountFromFloat64Round(tt.curr, tt.f, tt.mode)
+			if err != nil {
+				t.Errorf("NewAmountFromFloat64Round(%q, %v, %v) failed: %v", tt.curr, tt.f, tt.mode, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("NewAmountFromFloat64Round(%q, %v, %v) = %q, want %q", tt.curr, tt.f, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr string
+			f    float64
+		}{
+			"currency 1":      {"UUU", 0},
+			"overflow 1":      {"USD", 1e17},
+			"special value 1": {"USD", math.NaN()},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewAmountFromFloat64Round(tt.curr, tt.f)
+				if err == nil {
+					t.Errorf("NewAmountFromFloat64Round(%q, %v) did not fail", tt.curr, tt.f)
+				}
+			})
+		}
+	})
+}
+
 func TestNewAmountFromDecimal(t *testing.T) {
 	tests := []struct {
 		m      Currency
@@ -404,6 +619,97 @@ func TestParseAmount(t *testing.T) {
 	})
 }
 
+func FuzzParseAmount(f *testing.F) {
+	f.Add("USD", "12.34")
+	f.Add("usd", "-0.5")
+	f.Add("JPY", "1e3")
+	f.Add("OMR", "0.0001")
+	f.Add("XXX", "99999999999999999999")
+	f.Add("EUR", "")
+
+	f.Fuzz(
+		func(t *testing.T, curr, s string) {
+			a, err := ParseAmount(curr, s)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			if a.Scale() < a.Curr().Scale() {
+				t.Errorf("ParseAmount(%q, %q) = %v, scale less than %v", curr, s, a, a.Curr().Scale())
+			}
+			b, err := ParseAmount(a.Curr().Code(), a.Decimal().String())
+			if err != nil {
+				t.Errorf("ParseAmount(%q, %q) failed: %v", a.Curr().Code(), a.Decimal().String(), err)
+				return
+			}
+			if a != b {
+				t.Errorf("ParseAmount(%q, %q) = %q, want %q", a.Curr().Code(), a.Decimal().String(), b, a)
+			}
+		},
+	)
+}
+
+func TestParseShorthand(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, s, want string
+		}{
+			{"USD", "5", "5.00"},
+			{"USD", "5k", "5000.00"},
+			{"USD", "5K", "5000.00"},
+			{"USD", "-5k", "-5000.00"},
+			{"USD", "1.2m", "1200000.00"},
+			{"USD", "1.2M", "1200000.00"},
+			{"USD", "1.2mn", "1200000.00"},
+			{"USD", "3bn", "3000000000.00"},
+			{"USD", "3BN", "3000000000.00"},
+			{"USD", "0.5tn", "500000000000.00"},
+			{"USD", "1.2345k", "1234.50"},
+			{"USD", "1.23456k", "1234.56"},
+			{"USD", "0.000001m", "1.00"},
+			{"JPY", "1.5k", "1500"},
+			{"OMR", "2.5k", "2500.000"},
+		}
+		for _, tt := range tests {
+			got, err := ParseShorthand(tt.m, tt.s)
+			if err != nil {
+				t.Errorf("ParseShorthand(%q, %q) failed: %v", tt.m, tt.s, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("ParseShorthand(%q, %q) = %q, want %q", tt.m, tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, s string
+		}{
+			"currency 1": {"ZZZ", "5k"},
+			"suffix 1":   {"USD", "5x"},
+			"suffix 2":   {"USD", "5b"},
+			"suffix 3":   {"USD", "5mm"},
+			"suffix 4":   {"USD", "5kk"},
+			"suffix 5":   {"USD", "k"},
+			"decimal 1":  {"USD", "5 k"},
+			"decimal 2":  {"USD", "k5"},
+			"decimal 3":  {"USD", ""},
+			"overflow 1": {"USD", "1000000tn"},
+			"overflow 2": {"USD", "100000000bn"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseShorthand(tt.m, tt.s)
+				if err == nil {
+					t.Errorf("ParseShorthand(%q, %q) did not fail", tt.m, tt.s)
+				}
+			})
+		}
+	})
+}
+
 func TestMustParseAmount(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		defer func() {
@@ -480,6 +786,33 @@ func TestAmount_MinorUnits(t *testing.T) {
 	}
 }
 
+func TestAmount_MajorMinor(t *testing.T) {
+	tests := []struct {
+		m, d                 string
+		wantMajor, wantMinor int64
+		wantOk               bool
+	}{
+		{"USD", "0", 0, 0, true},
+		{"USD", "12.34", 12, 34, true},
+		{"USD", "-12.34", -12, -34, true},
+		{"USD", "0.05", 0, 5, true},
+		{"USD", "12.345", 12, 34, true},
+		{"USD", "12.355", 12, 36, true},
+		{"JPY", "1234", 1234, 0, true},
+		{"JPY", "1234.5", 1234, 0, true},
+		{"OMR", "1.234", 1, 234, true},
+		{"JPY", "9223372036854775807", 9223372036854775807, 0, true},
+		{"JPY", "9223372036854775808", 0, 0, false},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		gotMajor, gotMinor, gotOk := a.MajorMinor()
+		if gotMajor != tt.wantMajor || gotMinor != tt.wantMinor || gotOk != tt.wantOk {
+			t.Errorf("%q.MajorMinor() = [%v %v %v], want [%v %v %v]", a, gotMajor, gotMinor, gotOk, tt.wantMajor, tt.wantMinor, tt.wantOk)
+		}
+	}
+}
+
 func TestAmount_SameScaleAsCurr(t *testing.T) {
 	tests := []struct {
 		m, d string
@@ -499,6 +832,56 @@ func TestAmount_SameScaleAsCurr(t *testing.T) {
 	}
 }
 
+func TestAmount_EnsurePos(t *testing.T) {
+	tests := []struct {
+		m, d    string
+		wantErr string
+	}{
+		{"USD", "-5", "amount must be positive, got USD -5.00"},
+		{"USD", "-0.001", "amount must be positive, got USD -0.001"},
+		{"USD", "0", "amount must be positive, got USD 0.00"},
+		{"USD", "0.001", ""},
+		{"USD", "5", ""},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		err := a.EnsurePos()
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%q.EnsurePos() failed: %v", a, err)
+		case tt.wantErr != "" && err == nil:
+			t.Errorf("%q.EnsurePos() did not fail", a)
+		case tt.wantErr != "" && err.Error() != tt.wantErr:
+			t.Errorf("%q.EnsurePos() = %q, want %q", a, err, tt.wantErr)
+		}
+	}
+}
+
+func TestAmount_EnsureNonNeg(t *testing.T) {
+	tests := []struct {
+		m, d    string
+		wantErr string
+	}{
+		{"USD", "-5", "amount must be non-negative, got USD -5.00"},
+		{"USD", "-0.001", "amount must be non-negative, got USD -0.001"},
+		{"USD", "0", ""},
+		{"USD", "0.001", ""},
+		{"USD", "5", ""},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		err := a.EnsureNonNeg()
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%q.EnsureNonNeg() failed: %v", a, err)
+		case tt.wantErr != "" && err == nil:
+			t.Errorf("%q.EnsureNonNeg() did not fail", a)
+		case tt.wantErr != "" && err.Error() != tt.wantErr:
+			t.Errorf("%q.EnsureNonNeg() = %q, want %q", a, err, tt.wantErr)
+		}
+	}
+}
+
 func MustParseAmountSlice(curr string, amounts []string) []Amount {
 	res := make([]Amount, len(amounts))
 	for i := range len(amounts) {
@@ -507,6 +890,76 @@ func MustParseAmountSlice(curr string, amounts []string) []Amount {
 	return res
 }
 
+func TestAmount_Sign(t *testing.T) {
+	tests := []struct {
+		m, a                       string
+		wantSign                   int
+		wantPos, wantNeg, wantZero bool
+		wantOpp, wantAbs           string
+	}{
+		{"USD", "-1.00", -1, false, true, false, "1.00", "1.00"},
+		{"USD", "-0.01", -1, false, true, false, "0.01", "0.01"},
+		{"USD", "-0.00", 0, false, false, true, "0.00", "0.00"},
+		{"USD", "-0", 0, false, false, true, "0.00", "0.00"},
+		{"USD", "0.00", 0, false, false, true, "0.00", "0.00"},
+		{"USD", "0.01", 1, true, false, false, "-0.01", "0.01"},
+		{"JPY", "-0", 0, false, false, true, "0", "0"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.a)
+		if got := a.Sign(); got != tt.wantSign {
+			t.Errorf("%q.Sign() = %v, want %v", a, got, tt.wantSign)
+		}
+		if got := a.IsPos(); got != tt.wantPos {
+			t.Errorf("%q.IsPos() = %t, want %t", a, got, tt.wantPos)
+		}
+		if got := a.IsNeg(); got != tt.wantNeg {
+			t.Errorf("%q.IsNeg() = %t, want %t", a, got, tt.wantNeg)
+		}
+		if got := a.IsZero(); got != tt.wantZero {
+			t.Errorf("%q.IsZero() = %t, want %t", a, got, tt.wantZero)
+		}
+		if got, want := a.Neg(), MustParseAmount(tt.m, tt.wantOpp); got != want {
+			t.Errorf("%q.Neg() = %q, want %q", a, got, want)
+		}
+		if got, want := a.Abs(), MustParseAmount(tt.m, tt.wantAbs); got != want {
+			t.Errorf("%q.Abs() = %q, want %q", a, got, want)
+		}
+	}
+}
+
+func TestAmount_NegativeZero(t *testing.T) {
+	zero := MustParseAmount("USD", "0.00")
+	minusOne := decimal.MustParse("-1")
+	tests := map[string]func() (Amount, error){
+		"parse":      func() (Amount, error) { return ParseAmount("USD", "-0.00") },
+		"neg":        func() (Amount, error) { return zero.Neg(), nil },
+		"mul":        func() (Amount, error) { return zero.Mul(minusOne) },
+		"quo":        func() (Amount, error) { return zero.Quo(minusOne) },
+		"sub":        func() (Amount, error) { return MustParseAmount("USD", "-1.00").Sub(MustParseAmount("USD", "-1.00")) },
+		"round":      func() (Amount, error) { return MustParseAmount("USD", "-0.004").Round(2), nil },
+		"trunc":      func() (Amount, error) { return MustParseAmount("USD", "-0.009").Trunc(2), nil },
+		"ceil":       func() (Amount, error) { return MustParseAmount("USD", "-0.009").Ceil(2), nil },
+		"copy":       func() (Amount, error) { return zero.CopySign(MustParseAmount("USD", "-1.00")), nil },
+		"minor":      func() (Amount, error) { return NewAmountFromMinorUnits("USD", 0) },
+		"round with": func() (Amount, error) { return MustParseAmount("USD", "-0.001").RoundWith(2, HalfEven), nil },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := f()
+			if err != nil {
+				t.Fatalf("computing zero failed: %v", err)
+			}
+			if got != zero {
+				t.Errorf("got %q, want %q", got, zero)
+			}
+			if got.Sign() != 0 || got.IsNeg() || got.String() != "USD 0.00" {
+				t.Errorf("got %q with sign %v, want zero", got, got.Sign())
+			}
+		})
+	}
+}
+
 func TestAmount_Add(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -685,6 +1138,57 @@ func TestAmount_SubAbs(t *testing.T) {
 	})
 }
 
+func TestAmount_SubOverdraw(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d, e, want, wantOverdraw string
+		}{
+			// Not overdrawn
+			{"USD", "10", "3", "7", "0"},
+			{"USD", "10", "10", "0", "0"},
+			{"USD", "10", "-5", "15", "0"},
+			{"USD", "10", "9.999", "0.001", "0.000"},
+
+			// Overdrawn
+			{"USD", "10", "15", "-5", "5"},
+			{"USD", "0", "0.01", "-0.01", "0.01"},
+			{"USD", "-10", "5", "-15", "15"},
+			{"USD", "10", "10.001", "-0.001", "0.001"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			b := MustParseAmount(tt.m, tt.e)
+			got, gotOverdraw, err := a.SubOverdraw(b)
+			if err != nil {
+				t.Errorf("%q.SubOverdraw(%q) failed: %v", a, b, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			wantOverdraw := MustParseAmount(tt.m, tt.wantOverdraw)
+			if got != want || gotOverdraw != wantOverdraw {
+				t.Errorf("%q.SubOverdraw(%q) = %q, %q, want %q, %q", a, b, got, gotOverdraw, want, wantOverdraw)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, d, n, e string
+		}{
+			"currency 1": {"USD", "1", "JPY", "1"},
+			"overflow 1": {"USD", "-99999999999999999.99", "USD", "0.01"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			b := MustParseAmount(tt.n, tt.e)
+			_, _, err := a.SubOverdraw(b)
+			if err == nil {
+				t.Errorf("%q.SubOverdraw(%q) did not fail", a, b)
+			}
+		}
+	})
+}
+
 func TestAmount_AddMul(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -936,40 +1440,136 @@ func TestAmount_QuoRem(t *testing.T) {
 	})
 }
 
-func TestAmount_Mul(t *testing.T) {
+func TestAmount_DivMod(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			curr, a, e, want string
+			m, d             string
+			n                int64
+			wantQuo, wantRem string
 		}{
-			// There are few test cases here since Decimal.Mul
-			// is already tested by the cases in the decimal package.
-			{"USD", "2", "2", "4"},
-			{"USD", "2", "3", "6"},
-			{"USD", "5", "1", "5"},
-			{"USD", "5", "2", "10"},
-			{"USD", "1.20", "2", "2.40"},
-			{"USD", "1.20", "0", "0.00"},
-			{"USD", "1.20", "-2", "-2.40"},
-			{"USD", "-1.20", "2", "-2.40"},
-			{"USD", "-1.20", "0", "0.00"},
-			{"USD", "-1.20", "-2", "2.40"},
-			{"USD", "5.09", "7.1", "36.139"},
-			{"USD", "2.5", "4", "10.0"},
-			{"USD", "2.50", "4", "10.00"},
-			{"USD", "0.70", "1.05", "0.7350"},
+			{"USD", "0.00", 1, "0.00", "0.00"},
+			{"USD", "1.00", 3, "0.33", "0.01"},
+			{"USD", "100.00", 3, "33.33", "0.01"},
+			{"USD", "100.005", 3, "33.33", "0.015"},
+			{"USD", "-100.00", 3, "-33.33", "-0.01"},
+			{"USD", "100.00", -3, "-33.33", "0.01"},
+			{"USD", "0.02", 3, "0.00", "0.02"},
+			{"JPY", "100", 7, "14", "2"},
+			{"OMR", "1.000", 3, "0.333", "0.001"},
+			{"USD", "1.00", math.MaxInt64, "0.00", "1.00"},
+			{"USD", "99999999999999999.99", math.MaxInt64, "0.01", "7766279631452241.92"},
 		}
 		for _, tt := range tests {
-			a := MustParseAmount(tt.curr, tt.a)
-			e := decimal.MustParse(tt.e)
-			got, err := a.Mul(e)
+			a := MustParseAmount(tt.m, tt.d)
+			gotQuo, gotRem, err := a.DivMod(tt.n)
 			if err != nil {
-				t.Errorf("%q.Mul(%q) failed: %v", a, e, err)
+				t.Errorf("%q.DivMod(%v) failed: %v", a, tt.n, err)
 				continue
 			}
-			want := MustParseAmount(tt.curr, tt.want)
-			if got != want {
-				t.Errorf("%q.Mul(%q) = %q, want %q", a, e, got, want)
-			}
+			wantQuo := MustParseAmount(tt.m, tt.wantQuo)
+			wantRem := MustParseAmount(tt.m, tt.wantRem)
+			if gotQuo != wantQuo || gotRem != wantRem {
+				t.Errorf("%q.DivMod(%v) = [%q %q], want [%q %q]", a, tt.n, gotQuo, gotRem, wantQuo, wantRem)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "1.00")
+		_, _, err := a.DivMod(0)
+		if !errors.Is(err, errDivisionByZero) {
+			t.Errorf("%q.DivMod(0) = %v, want %v", a, err, errDivisionByZero)
+		}
+	})
+}
+
+func TestAmount_Ratio(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			a, b     string
+			num, den int64
+		}{
+			{"USD 0.00", "USD 3.00", 0, 1},
+			{"USD 1.00", "USD 3.00", 1, 3},
+			{"USD 3.00", "USD 1.00", 3, 1},
+			{"USD 2.50", "USD 10", 1, 4},
+			{"USD 10", "USD 2.50", 4, 1},
+			{"USD 0.01", "USD 100.00", 1, 10000},
+			{"USD -1.00", "USD 3.00", -1, 3},
+			{"USD 1.00", "USD -3.00", -1, 3},
+			{"USD -1.00", "USD -3.00", 1, 3},
+			{"EUR 8", "USD 10", 4, 5},
+			{"USD 0.000000000000000001", "USD 1", 1, 1000000000000000000},
+			{"USD 99999999999999999.99", "USD 0.03", 3333333333333333333, 1},
+		}
+		for _, tt := range tests {
+			a, b := mustParseSQLAmount(t, tt.a), mustParseSQLAmount(t, tt.b)
+			num, den, err := a.Ratio(b)
+			if err != nil {
+				t.Errorf("%q.Ratio(%q) failed: %v", a, b, err)
+				continue
+			}
+			if num != tt.num || den != tt.den {
+				t.Errorf("%q.Ratio(%q) = %v/%v, want %v/%v", a, b, num, den, tt.num, tt.den)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			a, b string
+		}{
+			"zero 1":     {"USD 1.00", "USD 0.00"},
+			"overflow 1": {"USD 1", "USD 0.0000000000000000003"},
+			"overflow 2": {"USD 99999999999999999.99", "USD 0.01"},
+			"overflow 3": {"USD 0.0000000000000000001", "USD 1"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a, b := mustParseSQLAmount(t, tt.a), mustParseSQLAmount(t, tt.b)
+				_, _, err := a.Ratio(b)
+				if err == nil {
+					t.Errorf("%q.Ratio(%q) did not fail", a, b)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_Mul(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, e, want string
+		}{
+			// There are few test cases here since Decimal.Mul
+			// is already tested by the cases in the decimal package.
+			{"USD", "2", "2", "4"},
+			{"USD", "2", "3", "6"},
+			{"USD", "5", "1", "5"},
+			{"USD", "5", "2", "10"},
+			{"USD", "1.20", "2", "2.40"},
+			{"USD", "1.20", "0", "0.00"},
+			{"USD", "1.20", "-2", "-2.40"},
+			{"USD", "-1.20", "2", "-2.40"},
+			{"USD", "-1.20", "0", "0.00"},
+			{"USD", "-1.20", "-2", "2.40"},
+			{"USD", "5.09", "7.1", "36.139"},
+			{"USD", "2.5", "4", "10.0"},
+			{"USD", "2.50", "4", "10.00"},
+			{"USD", "0.70", "1.05", "0.7350"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			e := decimal.MustParse(tt.e)
+			got, err := a.Mul(e)
+			if err != nil {
+				t.Errorf("%q.Mul(%q) failed: %v", a, e, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("%q.Mul(%q) = %q, want %q", a, e, got, want)
+			}
 		}
 	})
 
@@ -991,6 +1591,105 @@ func TestAmount_Mul(t *testing.T) {
 	})
 }
 
+func TestAmount_MulRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d, e string
+			r       RoundingMode
+			want    string
+		}{
+			{"USD", "2.50", "0.05", HalfEven, "0.12"},
+			{"USD", "2.50", "0.05", HalfUp, "0.13"},
+			{"USD", "2.50", "0.05", HalfDown, "0.12"},
+			{"USD", "2.50", "0.05", Ceiling, "0.13"},
+			{"USD", "2.50", "0.05", Floor, "0.12"},
+			{"USD", "2.50", "0.05", Truncate, "0.12"},
+			{"USD", "-2.50", "0.05", HalfUp, "-0.13"},
+			{"USD", "-2.50", "0.05", Ceiling, "-0.12"},
+			{"JPY", "1001", "0.1", HalfUp, "100"},
+			{"JPY", "1005", "0.1", HalfUp, "101"},
+			{"OMR", "1.2345", "1", HalfUp, "1.235"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			e := decimal.MustParse(tt.e)
+			got, err := a.MulRound(e, tt.r)
+			if err != nil {
+				t.Errorf("%q.MulRound(%v, %v) failed: %v", a, e, tt.r, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("%q.MulRound(%v, %v) = %q, want %q", a, e, tt.r, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "99999999999999999.99")
+		e := decimal.MustParse("2")
+		_, err := a.MulRound(e, HalfUp)
+		if err == nil {
+			t.Errorf("%q.MulRound(%v) did not fail", a, e)
+		}
+	})
+}
+
+func TestAmount_QuoRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d, e string
+			r       RoundingMode
+			want    string
+		}{
+			{"USD", "0.25", "2", HalfEven, "0.12"},
+			{"USD", "0.25", "2", HalfUp, "0.13"},
+			{"USD", "0.25", "2", HalfDown, "0.12"},
+			{"USD", "0.25", "2", Ceiling, "0.13"},
+			{"USD", "0.25", "2", Floor, "0.12"},
+			{"USD", "0.25", "2", Truncate, "0.12"},
+			{"USD", "1", "3", HalfUp, "0.33"},
+			{"USD", "2", "3", HalfDown, "0.67"},
+			{"USD", "-1", "3", Floor, "-0.34"},
+			{"USD", "-1", "3", Ceiling, "-0.33"},
+			{"JPY", "5", "2", HalfUp, "3"},
+			{"JPY", "5", "2", HalfEven, "2"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			e := decimal.MustParse(tt.e)
+			got, err := a.QuoRound(e, tt.r)
+			if err != nil {
+				t.Errorf("%q.QuoRound(%v, %v) failed: %v", a, e, tt.r, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("%q.QuoRound(%v, %v) = %q, want %q", a, e, tt.r, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, d, e string
+		}{
+			"zero 1":     {"USD", "1", "0"},
+			"overflow 1": {"USD", "99999999999999999", "0.1"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.d)
+				e := decimal.MustParse(tt.e)
+				_, err := a.QuoRound(e, HalfUp)
+				if err == nil {
+					t.Errorf("%q.QuoRound(%v) did not fail", a, e)
+				}
+			})
+		}
+	})
+}
+
 func TestAmount_Split(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -1051,6 +1750,163 @@ func TestAmount_Split(t *testing.T) {
 	})
 }
 
+func TestAmount_Allocate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d   string
+			ratios []int
+			want   []string
+		}{
+			{"USD", "0.00", []int{1, 1, 1}, []string{"0.00", "0.00", "0.00"}},
+			{"USD", "0.01", []int{1, 2}, []string{"0.00", "0.01"}},
+			{"USD", "0.05", []int{3, 7}, []string{"0.02", "0.03"}},
+			{"USD", "0.10", []int{70, 20, 10}, []string{"0.07", "0.02", "0.01"}},
+			{"USD", "1.00", []int{1, 2, 3}, []string{"0.17", "0.33", "0.50"}},
+			{"USD", "-1.00", []int{1, 2, 3}, []string{"-0.17", "-0.33", "-0.50"}},
+			{"USD", "0.03", []int{0, 1, 1}, []string{"0.00", "0.02", "0.01"}},
+			{"USD", "5.00", []int{0, 1, 0, 1}, []string{"0.00", "2.50", "0.00", "2.50"}},
+			{"USD", "100.00", []int{1, 1, 1}, []string{"33.34", "33.33", "33.33"}},
+			{"JPY", "1000", []int{1, 1, 1}, []string{"334", "333", "333"}},
+			{"OMR", "0.010", []int{1, 2}, []string{"0.003", "0.007"}},
+			{"JPY", "9999999999999999999", []int{1, 1}, []string{"5000000000000000000", "4999999999999999999"}},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			got, err := a.Allocate(tt.ratios...)
+			if err != nil {
+				t.Errorf("%q.Allocate(%v) failed: %v", a, tt.ratios, err)
+				continue
+			}
+			want := MustParseAmountSlice(tt.m, tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q.Allocate(%v) = %v, want %v", a, tt.ratios, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, d   string
+			ratios []int
+		}{
+			"ratios 1": {"USD", "1.00", nil},
+			"ratios 2": {"USD", "1.00", []int{1, -1}},
+			"ratios 3": {"USD", "1.00", []int{0, 0}},
+			"ratios 4": {"USD", "1.00", []int{math.MaxInt, math.MaxInt, math.MaxInt}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.d)
+				_, err := a.Allocate(tt.ratios...)
+				if err == nil {
+					t.Errorf("%q.Allocate(%v) did not fail", a, tt.ratios)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_AllocateSeeded(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d   string
+			ratios []int
+		}{
+			{"USD", "0", []int{1, 1, 1}},
+			{"USD", "1.00", []int{1, 1, 1}},
+			{"USD", "-1.00", []int{1, 1, 1}},
+			{"USD", "0.05", []int{1, 1, 1, 1, 1, 1, 1}},
+			{"USD", "100.00", []int{1, 2, 3}},
+			{"USD", "100.00", []int{0, 1, 0, 1, 0}},
+			{"USD", "100.000", []int{1, 1, 1}},
+			{"JPY", "1000", []int{3, 3, 3}},
+			{"OMR", "-0.010", []int{70, 20, 10}},
+			{"USD", "99999999999999999.99", []int{math.MaxInt32, math.MaxInt32, 1}},
+			{"JPY", "9999999999999999999", []int{1, 1, 1}},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			got, err := a.AllocateSeeded("invoice-1", tt.ratios...)
+			if err != nil {
+				t.Errorf("%q.AllocateSeeded(%v) failed: %v", a, tt.ratios, err)
+				continue
+			}
+			if len(got) != len(tt.ratios) {
+				t.Errorf("%q.AllocateSeeded(%v) returned %v parts, want %v", a, tt.ratios, len(got), len(tt.ratios))
+				continue
+			}
+			// Parts
+			sum := a.Zero()
+			for i, b := range got {
+				if !b.SameCurr(a) || !b.SameScale(a) {
+					t.Errorf("%q.AllocateSeeded(%v)[%v] = %q, want same currency and scale", a, tt.ratios, i, b)
+				}
+				if tt.ratios[i] == 0 && !b.IsZero() {
+					t.Errorf("%q.AllocateSeeded(%v)[%v] = %q, want zero", a, tt.ratios, i, b)
+				}
+				if b.Sign() != 0 && b.Sign() != a.Sign() {
+					t.Errorf("%q.AllocateSeeded(%v)[%v] = %q, want same sign", a, tt.ratios, i, b)
+				}
+				sum, err = sum.Add(b)
+				if err != nil {
+					t.Errorf("%q.Add(%q) failed: %v", sum, b, err)
+				}
+			}
+			// Sum
+			if sum != a {
+				t.Errorf("%q.AllocateSeeded(%v) = %v, sum %q, want %q", a, tt.ratios, got, sum, a)
+			}
+			// Determinism
+			again, err := a.AllocateSeeded("invoice-1", tt.ratios...)
+			if err != nil {
+				t.Errorf("%q.AllocateSeeded(%v) failed: %v", a, tt.ratios, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, again) {
+				t.Errorf("%q.AllocateSeeded(%v) = %v and %v, want identical results", a, tt.ratios, got, again)
+			}
+		}
+	})
+
+	t.Run("seed", func(t *testing.T) {
+		a := MustParseAmount("USD", "0.02")
+		seen := map[string]bool{}
+		for i := range 20 {
+			seed := fmt.Sprintf("invoice-%v", i)
+			got, err := a.AllocateSeeded(seed, 1, 1, 1)
+			if err != nil {
+				t.Errorf("%q.AllocateSeeded(%q) failed: %v", a, seed, err)
+				continue
+			}
+			seen[fmt.Sprint(got)] = true
+		}
+		if len(seen) < 2 {
+			t.Errorf("%q.AllocateSeeded distributed remainder identically for 20 seeds: %v", a, seen)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, d   string
+			ratios []int
+		}{
+			"ratios 1": {"USD", "1.00", nil},
+			"ratios 2": {"USD", "1.00", []int{1, -1}},
+			"ratios 3": {"USD", "1.00", []int{0, 0}},
+			"ratios 4": {"USD", "1.00", []int{math.MaxInt, math.MaxInt, math.MaxInt}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.d)
+				_, err := a.AllocateSeeded("invoice-1", tt.ratios...)
+				if err == nil {
+					t.Errorf("%q.AllocateSeeded(%v) did not fail", a, tt.ratios)
+				}
+			})
+		}
+	})
+}
+
 func TestAmount_String(t *testing.T) {
 	tests := []struct {
 		m, d, want string
@@ -1081,6 +1937,138 @@ func TestAmount_String(t *testing.T) {
 	}
 }
 
+func TestAmount_OFXAmount(t *testing.T) {
+	tests := []struct {
+		m, d, want string
+	}{
+		// Zeros
+		{"JPY", "0", "0"},
+		{"USD", "0", "0.00"},
+		{"USD", "-0.001", "0.00"},
+		{"OMR", "0", "0.000"},
+
+		// Rounding
+		{"USD", "1.005", "1.00"},
+		{"USD", "1.015", "1.02"},
+		{"USD", "-1.015", "-1.02"},
+		{"JPY", "1.5", "2"},
+
+		// No grouping
+		{"USD", "1234567.89", "1234567.89"},
+		{"USD", "-1234567.89", "-1234567.89"},
+		{"USD", "-5", "-5.00"},
+		{"OMR", "-0.5", "-0.500"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		got := a.OFXAmount()
+		if got != tt.want {
+			t.Errorf("%q.OFXAmount() = %q, want %q", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_QIFAmount(t *testing.T) {
+	tests := []struct {
+		m, d, want string
+	}{
+		// Zeros
+		{"JPY", "0", "0"},
+		{"USD", "0", "0.00"},
+		{"USD", "-0.001", "0.00"},
+		{"OMR", "0", "0.000"},
+
+		// Rounding
+		{"USD", "1.005", "1.00"},
+		{"USD", "1.015", "1.02"},
+		{"USD", "-1.015", "-1.02"},
+		{"JPY", "999.5", "1,000"},
+
+		// Grouping
+		{"USD", "0.5", "0.50"},
+		{"USD", "123", "123.00"},
+		{"USD", "1234", "1,234.00"},
+		{"USD", "123456", "123,456.00"},
+		{"USD", "1234567.89", "1,234,567.89"},
+		{"USD", "-1234567.89", "-1,234,567.89"},
+		{"USD", "-99999999999999999.99", "-99,999,999,999,999,999.99"},
+		{"JPY", "-1234", "-1,234"},
+		{"OMR", "-1234.5", "-1,234.500"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		got := a.QIFAmount()
+		if got != tt.want {
+			t.Errorf("%q.QIFAmount() = %q, want %q", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_FormatTAccount(t *testing.T) {
+	tests := []struct {
+		tag, m, d, wantDebit, wantCredit string
+	}{
+		// Positive
+		{"en", "USD", "1234.56", "$1,234.56", ""},
+		{"en", "USD", "0.005", "", ""},
+		{"en", "USD", "0.015", "$0.02", ""},
+		{"de", "EUR", "1234.56", "1.234,56\u00a0€", ""},
+
+		// Negative
+		{"en", "USD", "-1234.56", "", "$1,234.56"},
+		{"en", "USD", "-0.005", "", ""},
+		{"en", "USD", "-0.015", "", "$0.02"},
+		{"de", "EUR", "-1234.56", "", "1.234,56\u00a0€"},
+
+		// Zero
+		{"en", "USD", "0", "", ""},
+		{"en", "JPY", "0.000", "", ""},
+		{"de", "EUR", "0.00", "", ""},
+	}
+	for _, tt := range tests {
+		tag := language.MustParse(tt.tag)
+		a := MustParseAmount(tt.m, tt.d)
+		gotDebit, gotCredit := a.FormatTAccount(tag)
+		if gotDebit != tt.wantDebit || gotCredit != tt.wantCredit {
+			t.Errorf("%q.FormatTAccount(%q) = %q, %q, want %q, %q", a, tag, gotDebit, gotCredit, tt.wantDebit, tt.wantCredit)
+		}
+	}
+}
+
+func TestAmount_FormatTrimWhole(t *testing.T) {
+	tests := []struct {
+		tag, m, d, want string
+	}{
+		// Whole
+		{"en", "USD", "5", "$5"},
+		{"en", "USD", "5.00", "$5"},
+		{"en", "USD", "-5.00", "-$5"},
+		{"en", "USD", "0", "$0"},
+		{"en", "USD", "1234", "$1,234"},
+		{"en", "USD", "4.999", "$5"},
+		{"en", "USD", "5.005", "$5"},
+		{"en", "JPY", "1234", "¥1,234"},
+		{"de", "EUR", "1234.00", "1.234\u00a0€"},
+
+		// Fractional
+		{"en", "USD", "5.50", "$5.50"},
+		{"en", "USD", "5.5", "$5.50"},
+		{"en", "USD", "-5.5", "-$5.50"},
+		{"en", "USD", "5.015", "$5.02"},
+		{"en", "USD", "1234.56", "$1,234.56"},
+		{"en", "OMR", "5.5", "OMR\u00a05.500"},
+		{"de", "EUR", "1234.5", "1.234,50\u00a0€"},
+	}
+	for _, tt := range tests {
+		tag := language.MustParse(tt.tag)
+		a := MustParseAmount(tt.m, tt.d)
+		got := a.FormatTrimWhole(tag)
+		if got != tt.want {
+			t.Errorf("%q.FormatTrimWhole(%q) = %q, want %q", a, tag, got, tt.want)
+		}
+	}
+}
+
 func TestAmount_Format(t *testing.T) {
 	tests := []struct {
 		m, d, format, want string
@@ -1101,18 +2089,26 @@ func TestAmount_Format(t *testing.T) {
 		{"USD", "100.00", "%-13q", "\"USD 100.00\" "},
 		{"USD", "100.00", "%+-015q", "\"USD +100.00\"  "}, // '0' is ignored
 		// %s verb
-		{"USD", "100.00", "%s", "USD 100.00"},
-		{"USD", "100.00", "%+s", "USD +100.00"},
-		{"USD", "100.00", "% s", "USD  100.00"},
-		{"USD", "100.00", "%.6s", "USD 100.00"}, // precision is ignored
-		{"USD", "100.00", "%10s", "USD 100.00"},
-		{"USD", "100.00", "%11s", " USD 100.00"},
-		{"USD", "100.00", "%12s", "  USD 100.00"},
-		{"USD", "100.00", "%13s", "   USD 100.00"},
-		{"USD", "100.00", "%013s", "USD 000100.00"},
-		{"USD", "100.00", "%+13s", "  USD +100.00"},
-		{"USD", "100.00", "%-13s", "USD 100.00   "},
-		{"USD", "100.00", "%+-015s", "USD +100.00    "}, // '0' is ignored
+		{"USD", "100.00", "%s", "$100.00"},
+		{"USD", "-100.00", "%s", "-$100.00"},
+		{"USD", "100.00", "%+s", "+$100.00"},
+		{"USD", "100.00", "% s", " $100.00"},
+		{"USD", "100.00", "%.6s", "$100.00"}, // precision is ignored
+		{"USD", "100.00", "%7s", "$100.00"},
+		{"USD", "100.00", "%8s", " $100.00"},
+		{"USD", "100.00", "%10s", "   $100.00"},
+		{"USD", "100.00", "%010s", "$000100.00"},
+		{"USD", "-100.00", "%010s", "-$00100.00"},
+		{"USD", "100.00", "%+10s", "  +$100.00"},
+		{"USD", "100.00", "%-10s", "$100.00   "},
+		{"USD", "100.00", "%+-010s", "+$100.00  "}, // '0' is ignored
+		{"CAD", "5.68", "%s", "CA$5.68"},
+		{"EUR", "5.68", "%s", "€5.68"},
+		{"EUR", "-5.68", "%8s", "  -€5.68"},
+		{"EUR", "5.68", "%-7s", "€5.68  "},
+		{"CHF", "5.68", "%s", "CHF 5.68"},
+		{"CHF", "-5.68", "%s", "-CHF 5.68"},
+		{"CHF", "5.68", "%010s", "CHF 005.68"},
 		// %v verb
 		{"USD", "100.00", "%v", "USD 100.00"},
 		{"USD", "100.00", "%+v", "USD +100.00"},
@@ -1663,13 +2659,153 @@ func TestAmount_Rescale(t *testing.T) {
 	}
 }
 
-func TestAmount_Quantize(t *testing.T) {
+func TestAmount_RoundWith(t *testing.T) {
 	tests := []struct {
-		m, d, e, want string
+		m, d  string
+		scale int
+		r     RoundingMode
+		want  string
 	}{
-		// Padding
-		{"JPY", "0", "0", "0"},
-		{"JPY", "0", "0.0", "0.0"},
+		// Padding to currency scale
+		{"USD", "1.5", 0, HalfUp, "2.00"},
+		{"USD", "2.5", 0, HalfDown, "2.00"},
+		{"OMR", "-1.5", 0, HalfUp, "-2.000"},
+		{"JPY", "1.5", 0, HalfUp, "2"},
+
+		// Rounding modes
+		{"USD", "2.125", 2, HalfEven, "2.12"},
+		{"USD", "2.125", 2, HalfUp, "2.13"},
+		{"USD", "2.125", 2, HalfDown, "2.12"},
+		{"USD", "2.125", 2, Ceiling, "2.13"},
+		{"USD", "2.125", 2, Floor, "2.12"},
+		{"USD", "2.125", 2, Truncate, "2.12"},
+		{"USD", "-2.125", 2, HalfEven, "-2.12"},
+		{"USD", "-2.125", 2, HalfUp, "-2.13"},
+		{"USD", "-2.125", 2, HalfDown, "-2.12"},
+		{"USD", "-2.125", 2, Ceiling, "-2.12"},
+		{"USD", "-2.125", 2, Floor, "-2.13"},
+		{"USD", "-2.125", 2, Truncate, "-2.12"},
+
+		// No rounding
+		{"USD", "2.12", 3, HalfUp, "2.12"},
+		{"USD", "2.1251", 4, HalfDown, "2.1251"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		got := a.RoundWith(tt.scale, tt.r)
+		want := MustParseAmount(tt.m, tt.want)
+		if got != want {
+			t.Errorf("%q.RoundWith(%v, %v) = %q, want %q", a, tt.scale, tt.r, got, want)
+		}
+	}
+}
+
+func TestAmount_RoundToCash(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d string
+			r    RoundingMode
+			want string
+		}{
+			{"CHF", "12.34", HalfEven, "12.35"},
+			{"CHF", "12.32", HalfEven, "12.30"},
+			{"CHF", "12.325", HalfEven, "12.30"},
+			{"CHF", "12.325", HalfUp, "12.35"},
+			{"CHF", "-12.34", HalfEven, "-12.35"},
+			{"CAD", "0.03", HalfEven, "0.05"},
+			{"DKK", "10.24", HalfEven, "10.00"},
+			{"DKK", "10.26", HalfEven, "10.50"},
+			{"SEK", "10.49", HalfEven, "10.00"},
+			{"SEK", "10.50", HalfEven, "10.00"},
+			{"SEK", "10.50", HalfUp, "11.00"},
+			{"SEK", "10.01", Ceiling, "11.00"},
+			{"USD", "12.345", HalfEven, "12.34"},
+			{"USD", "12.34", Ceiling, "12.34"},
+			{"JPY", "123.4", HalfEven, "123"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			got, err := a.RoundToCash(tt.r)
+			if err != nil {
+				t.Errorf("%q.RoundToCash(%v) failed: %v", a, tt.r, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("%q.RoundToCash(%v) = %q, want %q", a, tt.r, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("SEK", "99999999999999999.99")
+		_, err := a.RoundToCash(HalfUp)
+		if err == nil {
+			t.Errorf("%q.RoundToCash(%v) did not fail", a, HalfUp)
+		}
+	})
+}
+
+func TestAmount_RoundToUnit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d, u string
+			r       RoundingMode
+			want    string
+		}{
+			{"USD", "12.34", "0.25", HalfEven, "12.25"},
+			{"USD", "12.40", "0.25", HalfEven, "12.50"},
+			{"USD", "12.34", "0.25", Ceiling, "12.50"},
+			{"USD", "12.34", "10", HalfEven, "10.00"},
+			{"USD", "12.34", "0.001", HalfEven, "12.34"},
+			{"USD", "12.3456", "0.001", HalfEven, "12.346"},
+			{"JPY", "1234", "100", HalfEven, "1200"},
+			{"JPY", "1250", "100", HalfUp, "1300"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			u := decimal.MustParse(tt.u)
+			got, err := a.RoundToUnit(u, tt.r)
+			if err != nil {
+				t.Errorf("%q.RoundToUnit(%v, %v) failed: %v", a, u, tt.r, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("%q.RoundToUnit(%v, %v) = %q, want %q", a, u, tt.r, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, d, u string
+		}{
+			"zero unit":     {"USD", "1", "0"},
+			"negative unit": {"USD", "1", "-0.05"},
+			"overflow 1":    {"JPY", "9999999999999999999", "0.01"},
+			"overflow 2":    {"USD", "99999999999999999.99", "1"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.d)
+				u := decimal.MustParse(tt.u)
+				_, err := a.RoundToUnit(u, HalfUp)
+				if err == nil {
+					t.Errorf("%q.RoundToUnit(%v) did not fail", a, u)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_Quantize(t *testing.T) {
+	tests := []struct {
+		m, d, e, want string
+	}{
+		// Padding
+		{"JPY", "0", "0", "0"},
+		{"JPY", "0", "0.0", "0.0"},
 		{"JPY", "0", "0.00", "0.00"},
 		{"JPY", "0", "0.000", "0.000"},
 		{"USD", "0", "0", "0.00"},
@@ -1724,3 +2860,1071 @@ func TestAmount_Quantize(t *testing.T) {
 		}
 	}
 }
+
+func TestAmount_Scan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value   any
+			m, want string
+		}{
+			{"USD 12.34", "USD", "12.34"},
+			{"USD -12.3", "USD", "-12.30"},
+			{[]byte("JPY 1"), "JPY", "1"},
+			{"(USD,12.34)", "USD", "12.34"},
+			{"(OMR,-0.0001)", "OMR", "-0.0001"},
+			{[]byte("(EUR,0)"), "EUR", "0.00"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.Scan(tt.value)
+			if err != nil {
+				t.Errorf("Scan(%q) failed: %v", tt.value, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("Scan(%q) = %q, want %q", tt.value, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{"", "USD", "12.34", "UUU 12.34", "USD 1.2.3", "(USD 12.34)", "(USD,)", 1234, 12.34, nil}
+		for _, tt := range tests {
+			var got Amount
+			err := got.Scan(tt)
+			if err == nil {
+				t.Errorf("Scan(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestAmount_Value(t *testing.T) {
+	tests := []struct {
+		m, d string
+		want driver.Value
+	}{
+		{"USD", "12.34", "USD 12.34"},
+		{"USD", "-12.3", "USD -12.30"},
+		{"JPY", "1", "JPY 1"},
+		{"OMR", "0.0001", "OMR 0.0001"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		got, err := a.Value()
+		if err != nil {
+			t.Errorf("%q.Value() failed: %v", a, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q.Value() = %q, want %q", a, got, tt.want)
+		}
+		var b Amount
+		err = b.Scan(got)
+		if err != nil {
+			t.Errorf("Scan(%q) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("Scan(%q) = %q, want %q", got, b, a)
+		}
+	}
+}
+
+// bsonElem returns a BSON element with the given type, name, and value.
+func bsonElem(typ byte, name string, value []byte) []byte {
+	elem := append([]byte{typ}, name...)
+	elem = append(elem, 0)
+	return append(elem, value...)
+}
+
+// bsonDoc returns a BSON document with the given elements.
+func bsonDoc(elems ...[]byte) []byte {
+	l := 4 + 1
+	for _, e := range elems {
+		l += len(e)
+	}
+	doc := []byte{byte(l), byte(l >> 8), byte(l >> 16), byte(l >> 24)}
+	for _, e := range elems {
+		doc = append(doc, e...)
+	}
+	return append(doc, 0)
+}
+
+// bsonString returns the value of a BSON string.
+func bsonString(s string) []byte {
+	l := len(s) + 1
+	return append(append([]byte{byte(l), 0, 0, 0}, s...), 0)
+}
+
+func TestAmount_MarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		m, d string
+	}{
+		{"USD", "12.34"},
+		{"USD", "-12.3"},
+		{"JPY", "1"},
+		{"OMR", "0.0001"},
+		{"USD", "99999999999999999.99"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		typ, data, err := a.MarshalBSONValue()
+		if err != nil {
+			t.Errorf("%q.MarshalBSONValue() failed: %v", a, err)
+			continue
+		}
+		_, dec, _ := a.Decimal().MarshalBSONValue()
+		want := bsonDoc(bsonElem(19, "amount", dec), bsonElem(2, "currency", bsonString(tt.m)))
+		if typ != 3 || !reflect.DeepEqual(data, want) {
+			t.Errorf("%q.MarshalBSONValue() = %v, [% x], want 3, [% x]", a, typ, data, want)
+			continue
+		}
+		var b Amount
+		err = b.UnmarshalBSONValue(typ, data)
+		if err != nil {
+			t.Errorf("UnmarshalBSONValue(%v, [% x]) failed: %v", typ, data, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("UnmarshalBSONValue(%v, [% x]) = %q, want %q", typ, data, b, a)
+		}
+	}
+}
+
+func TestAmount_UnmarshalBSONValue(t *testing.T) {
+	_, dec, _ := decimal.MustParse("12.3").MarshalBSONValue()
+	_, big, _ := decimal.MustParse("1234567890123456789").MarshalBSONValue()
+	curr := bsonElem(2, "currency", bsonString("USD"))
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data    []byte
+			m, want string
+		}{
+			{bsonDoc(bsonElem(19, "amount", dec), curr), "USD", "12.30"},
+			{bsonDoc(curr, bsonElem(19, "amount", dec)), "USD", "12.30"},
+			{bsonDoc(bsonElem(2, "amount", bsonString("-0.5")), curr), "USD", "-0.50"},
+			{bsonDoc(bsonElem(16, "amount", []byte{0xd2, 0x04, 0, 0}), curr), "USD", "12.34"},
+			{bsonDoc(bsonElem(16, "amount", []byte{0x2e, 0xfb, 0xff, 0xff}), curr), "USD", "-12.34"},
+			{bsonDoc(bsonElem(18, "amount", []byte{0xd2, 0x04, 0, 0, 0, 0, 0, 0}), bsonElem(2, "currency", bsonString("JPY"))), "JPY", "1234"},
+			{bsonDoc(bsonElem(18, "amount", []byte{0x2e, 0xfb, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}), curr), "USD", "-12.34"},
+			{bsonDoc(bsonElem(10, "note", nil), bsonElem(8, "final", []byte{1}), bsonElem(19, "amount", dec), bsonElem(3, "meta", bsonDoc()), curr), "USD", "12.30"},
+			{bsonDoc(bsonElem(7, "_id", make([]byte, 12)), curr, bsonElem(18, "amount", []byte{0xd2, 0x04, 0, 0, 0, 0, 0, 0})), "USD", "12.34"},
+			{bsonDoc(bsonElem(5, "hash", []byte{2, 0, 0, 0, 0, 0xab, 0xcd}), bsonElem(19, "amount", dec), curr), "USD", "12.30"},
+			{bsonDoc(bsonElem(11, "pattern", []byte("^a\x00i\x00")), bsonElem(19, "amount", dec), curr), "USD", "12.30"},
+			{bsonDoc(bsonElem(13, "code", bsonString("x()")), bsonElem(14, "symbol", bsonString("s")), bsonElem(19, "amount", dec), curr), "USD", "12.30"},
+			{bsonDoc(bsonElem(6, "undefined", nil), bsonElem(127, "max", nil), bsonElem(255, "min", nil), bsonElem(19, "amount", dec), curr), "USD", "12.30"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalBSONValue(3, tt.data)
+			if err != nil {
+				t.Errorf("UnmarshalBSONValue(3, [% x]) failed: %v", tt.data, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("UnmarshalBSONValue(3, [% x]) = %q, want %q", tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			typ  byte
+			data []byte
+		}{
+			"null":             {10, nil},
+			"string":           {2, bsonString("USD 12.34")},
+			"empty":            {3, nil},
+			"empty document":   {3, bsonDoc()},
+			"missing amount":   {3, bsonDoc(curr)},
+			"missing currency": {3, bsonDoc(bsonElem(19, "amount", dec))},
+			"unknown currency": {3, bsonDoc(bsonElem(19, "amount", dec), bsonElem(2, "currency", bsonString("ABC")))},
+			"numeric currency": {3, bsonDoc(bsonElem(19, "amount", dec), bsonElem(16, "currency", []byte{0x48, 0x03, 0, 0}))},
+			"double amount":    {3, bsonDoc(bsonElem(1, "amount", make([]byte, 8)), curr)},
+			"invalid amount":   {3, bsonDoc(bsonElem(2, "amount", bsonString("abc")), curr)},
+			"overflow":         {3, bsonDoc(bsonElem(19, "amount", big), curr)},
+			"unsupported type": {3, bsonDoc(bsonElem(32, "unknown", make([]byte, 12)), bsonElem(19, "amount", dec), curr)},
+			"short object id":  {3, bsonDoc(bsonElem(19, "amount", dec), curr, bsonElem(7, "_id", make([]byte, 11)))},
+			"short binary":     {3, bsonDoc(bsonElem(19, "amount", dec), curr, bsonElem(5, "hash", []byte{9, 0, 0, 0, 0, 0xab}))},
+			"short regex":      {3, bsonDoc(bsonElem(19, "amount", dec), curr, bsonElem(11, "pattern", []byte("^a\x00i")))},
+			"short value":      {3, bsonDoc(bsonElem(19, "amount", dec[:8]), curr)},
+			"wrong length":     {3, bsonDoc(bsonElem(19, "amount", dec), curr)[:20]},
+			"missing name end": {3, []byte{8, 0, 0, 0, 19, 'a', 'b', 0}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				var got Amount
+				err := got.UnmarshalBSONValue(tt.typ, tt.data)
+				if err == nil {
+					t.Errorf("UnmarshalBSONValue(%v, [% x]) did not fail", tt.typ, tt.data)
+				}
+			})
+		}
+	})
+}
+
+func TestNullAmount_Interfaces(t *testing.T) {
+	var i any = NullAmount{}
+	_, ok := i.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", i)
+	}
+
+	i = &NullAmount{}
+	_, ok = i.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", i)
+	}
+}
+
+func TestNullAmount_Scan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value any
+			want  NullAmount
+		}{
+			{nil, NullAmount{}},
+			{"USD 12.34", NullAmount{Amount: MustParseAmount("USD", "12.34"), Valid: true}},
+		}
+		for _, tt := range tests {
+			got := NullAmount{Amount: MustParseAmount("EUR", "1"), Valid: true}
+			err := got.Scan(tt.value)
+			if err != nil {
+				t.Errorf("Scan(%q) failed: %v", tt.value, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{"UUU 12.34", 1234}
+		for _, tt := range tests {
+			var got NullAmount
+			err := got.Scan(tt)
+			if err == nil {
+				t.Errorf("Scan(%q) did not fail", tt)
+			}
+			if got.Valid {
+				t.Errorf("Scan(%q) set Valid to true", tt)
+			}
+		}
+	})
+}
+
+func TestNewMinorUnits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d string
+			want int64
+		}{
+			{"USD", "12.34", 1234},
+			{"USD", "-12.3", -1230},
+			{"USD", "12.3400", 1234},
+			{"JPY", "1.0", 1},
+			{"OMR", "0.001", 1},
+			{"USD", "92233720368547758.07", math.MaxInt64},
+			{"USD", "-92233720368547758.08", math.MinInt64},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			got, err := NewMinorUnits(a)
+			if err != nil {
+				t.Errorf("NewMinorUnits(%q) failed: %v", a, err)
+				continue
+			}
+			want := MinorUnits{Curr: a.Curr(), Units: tt.want}
+			if got != want {
+				t.Errorf("NewMinorUnits(%q) = %v, want %v", a, got, want)
+			}
+			b, err := got.Amount()
+			if err != nil {
+				t.Errorf("%v.Amount() failed: %v", got, err)
+				continue
+			}
+			if ok, _ := b.Equal(a); !ok {
+				t.Errorf("%v.Amount() = %q, want %q", got, b, a)
+			}
+			if !b.SameScaleAsCurr() {
+				t.Errorf("%v.Amount() = %q, want scale %v", got, b, a.Curr().Scale())
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			m, d string
+		}{
+			{"USD", "12.345"},
+			{"JPY", "0.5"},
+			{"USD", "92233720368547758.08"},
+			{"JPY", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			_, err := NewMinorUnits(a)
+			if err == nil {
+				t.Errorf("NewMinorUnits(%q) did not fail", a)
+			}
+		}
+	})
+}
+
+// yamlUnmarshal returns a function that decodes a scalar or a mapping into
+// strings, like the YAML packages do.
+func yamlUnmarshal(node any) func(any) error {
+	return func(out any) error {
+		switch node := node.(type) {
+		case string:
+			s, ok := out.(*string)
+			if !ok {
+				return fmt.Errorf("cannot unmarshal scalar into %T", out)
+			}
+			*s = node
+		case map[string]string:
+			v := reflect.ValueOf(out).Elem()
+			if v.Kind() != reflect.Struct {
+				return fmt.Errorf("cannot unmarshal mapping into %T", out)
+			}
+			for i := range v.NumField() {
+				v.Field(i).SetString(node[v.Type().Field(i).Tag.Get("yaml")])
+			}
+		}
+		return nil
+	}
+}
+
+func TestAmount_UnmarshalYAML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			node    any
+			m, want string
+		}{
+			{"USD 9.99", "USD", "9.99"},
+			{"9.99 USD", "USD", "9.99"},
+			{" -9.9  usd ", "USD", "-9.90"},
+			{"JPY 1000", "JPY", "1000"},
+			{map[string]string{"amount": "9.99", "currency": "USD"}, "USD", "9.99"},
+			{map[string]string{"amount": "0.1234567890123456789", "currency": "OMR"}, "OMR", "0.1234567890123456789"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalYAML(yamlUnmarshal(tt.node))
+			if err != nil {
+				t.Errorf("UnmarshalYAML(%v) failed: %v", tt.node, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("UnmarshalYAML(%v) = %q, want %q", tt.node, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{
+			"",
+			"USD",
+			"9.99",
+			"ABC 9.99",
+			"9.99 ABC",
+			"USD 9.9.9",
+			map[string]string{"amount": "9.99"},
+			map[string]string{"currency": "USD"},
+			map[string]string{"amount": "abc", "currency": "USD"},
+			[]string{"USD", "9.99"},
+		}
+		for _, node := range tests {
+			var got Amount
+			err := got.UnmarshalYAML(yamlUnmarshal(node))
+			if err == nil {
+				t.Errorf("UnmarshalYAML(%v) did not fail", node)
+			}
+		}
+	})
+}
+
+func TestAmount_MarshalYAML(t *testing.T) {
+	tests := []struct {
+		m, d, want string
+	}{
+		{"USD", "9.99", "USD 9.99"},
+		{"USD", "-9.9", "USD -9.90"},
+		{"JPY", "1000", "JPY 1000"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		got, err := a.MarshalYAML()
+		if err != nil {
+			t.Errorf("%q.MarshalYAML() failed: %v", a, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q.MarshalYAML() = %v, want %v", a, got, tt.want)
+		}
+		var b Amount
+		err = b.UnmarshalYAML(yamlUnmarshal(got))
+		if err != nil {
+			t.Errorf("UnmarshalYAML(%v) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("UnmarshalYAML(%v) = %q, want %q", got, b, a)
+		}
+	}
+}
+
+func TestAmount_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data    string
+			m, want string
+		}{
+			{`{"amount":"12.34","currency":"USD"}`, "USD", "12.34"},
+			{`{"currency":"USD","amount":"-12.3"}`, "USD", "-12.30"},
+			{`{"amount":12.34,"currency":"USD"}`, "USD", "12.34"},
+			{`{"amount":"1","currency":"jpy"}`, "JPY", "1"},
+			{`"USD 12.34"`, "USD", "12.34"},
+			{`"OMR 0.0001"`, "OMR", "0.0001"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if err != nil {
+				t.Errorf("UnmarshalJSON(%q) failed: %v", tt.data, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("UnmarshalJSON(%q) = %q, want %q", tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			``, `{}`, `[]`, `12.34`, `"12.34"`, `"USD"`, `"UUU 12.34"`, `"(USD,12.34)"`,
+			`{"amount":"12.34"}`, `{"currency":"USD"}`, `{"amount":"abc","currency":"USD"}`,
+			`{"amount":"12.34","currency":"UUU"}`, `{"amount":true,"currency":"USD"}`,
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalJSON([]byte(tt))
+			if err == nil {
+				t.Errorf("UnmarshalJSON(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestAmount_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		m, d, want string
+	}{
+		{"USD", "12.34", `{"amount":"12.34","currency":"USD"}`},
+		{"USD", "-12.3", `{"amount":"-12.30","currency":"USD"}`},
+		{"JPY", "1", `{"amount":"1","currency":"JPY"}`},
+		{"OMR", "0.0001", `{"amount":"0.0001","currency":"OMR"}`},
+		{"XXX", "0", `{"amount":"0","currency":"XXX"}`},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.m, tt.d)
+		got, err := json.Marshal(a)
+		if err != nil {
+			t.Errorf("json.Marshal(%q) failed: %v", a, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%q) = %s, want %s", a, got, tt.want)
+		}
+		var b Amount
+		err = json.Unmarshal(got, &b)
+		if err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("json.Unmarshal(%s) = %q, want %q", got, b, a)
+		}
+	}
+}
+
+func FuzzAmount_JSON(f *testing.F) {
+	f.Add(`{"amount":"12.34","currency":"USD"}`)
+	f.Add(`{"amount":"-0.0001","currency":"OMR"}`)
+	f.Add(`{"amount":"1","currency":"JPY"}`)
+	f.Add(`{"currency":"EUR","amount":"1e3"}`)
+	f.Add(`"USD 1.00"`)
+	f.Add(`null`)
+
+	f.Fuzz(
+		func(t *testing.T, s string) {
+			var a Amount
+			if err := json.Unmarshal([]byte(s), &a); err != nil {
+				t.Skip()
+				return
+			}
+			data, err := json.Marshal(a)
+			if err != nil {
+				t.Errorf("json.Marshal(%q) failed: %v", a, err)
+				return
+			}
+			var b Amount
+			if err := json.Unmarshal(data, &b); err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", data, err)
+				return
+			}
+			if a != b {
+				t.Errorf("json.Unmarshal(json.Marshal(%q)) = %q", a, b)
+			}
+		},
+	)
+}
+
+func TestAmount_UnmarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			text, m, want string
+		}{
+			{"USD 12.34", "USD", "12.34"},
+			{"JPY -1", "JPY", "-1"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalText([]byte(tt.text))
+			if err != nil {
+				t.Errorf("UnmarshalText(%q) failed: %v", tt.text, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("UnmarshalText(%q) = %q, want %q", tt.text, got, want)
+			}
+			text, err := got.MarshalText()
+			if err != nil {
+				t.Errorf("%q.MarshalText() failed: %v", got, err)
+				continue
+			}
+			if string(text) != want.String() {
+				t.Errorf("%q.MarshalText() = %q, want %q", got, text, want.String())
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "USD", "12.34", "UUU 12.34", "USD abc", "(USD,12.34)", "(USD 12.34)"}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalText([]byte(tt))
+			if err == nil {
+				t.Errorf("UnmarshalText(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestAmount_UnmarshalBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data, m, want string
+		}{
+			{"USD\x00\x00\x00\x00\x00\x00\x00\x04\xd2\x02", "USD", "12.34"},
+			{"USD\x00\x00\x00\x00\x00\x00\x00\x30\x39\x03", "USD", "12.345"},
+			{"USD\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02", "USD", "0.00"},
+			{"JPY\x01\x00\x00\x00\x00\x00\x00\x00\x01\x00", "JPY", "-1"},
+			{"OMR\x00\x00\x00\x00\x00\x00\x00\x00\x01\x04", "OMR", "0.0001"},
+			{"USD\x01\x8a\xc7\x23\x04\x89\xe7\xff\xff\x02", "USD", "-99999999999999999.99"},
+			{"JPY\x00\x8a\xc7\x23\x04\x89\xe7\xff\xff\x00", "JPY", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalBinary([]byte(tt.data))
+			if err != nil {
+				t.Errorf("UnmarshalBinary(%q) failed: %v", tt.data, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("UnmarshalBinary(%q) = %q, want %q", tt.data, got, want)
+			}
+			data, err := got.MarshalBinary()
+			if err != nil {
+				t.Errorf("%q.MarshalBinary() failed: %v", got, err)
+				continue
+			}
+			if string(data) != tt.data {
+				t.Errorf("%q.MarshalBinary() = %q, want %q", got, data, tt.data)
+			}
+			data, err = got.AppendBinary([]byte("x"))
+			if err != nil {
+				t.Errorf("%q.AppendBinary() failed: %v", got, err)
+				continue
+			}
+			if string(data) != "x"+tt.data {
+				t.Errorf("%q.AppendBinary(\"x\") = %q, want %q", got, data, "x"+tt.data)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"empty":            "",
+			"text":             "USD 12.34",
+			"composite":        "(USD,12.34)",
+			"short":            "USD\x00\x00\x00\x00\x00\x00\x00\x04\xd2",
+			"long":             "USD\x00\x00\x00\x00\x00\x00\x00\x04\xd2\x02\x00",
+			"unknown currency": "UUU\x00\x00\x00\x00\x00\x00\x00\x04\xd2\x02",
+			"lower currency":   "usd\x00\x00\x00\x00\x00\x00\x00\x04\xd2\x02",
+			"numeric currency": "840\x00\x00\x00\x00\x00\x00\x00\x04\xd2\x02",
+			"sign":             "USD\x02\x00\x00\x00\x00\x00\x00\x04\xd2\x02",
+			"negative zero":    "USD\x01\x00\x00\x00\x00\x00\x00\x00\x00\x02",
+			"coefficient":      "USD\x00\x8a\xc7\x23\x04\x89\xe8\x00\x00\x02",
+			"scale":            "USD\x00\x00\x00\x00\x00\x00\x00\x04\xd2\x14",
+			"padding overflow": "USD\x00\x8a\xc7\x23\x04\x89\xe7\xff\xff\x00",
+		}
+		for name, tt := range tests {
+			var got Amount
+			err := got.UnmarshalBinary([]byte(tt))
+			if err == nil {
+				t.Errorf("UnmarshalBinary(%q) did not fail for %v", tt, name)
+			}
+		}
+	})
+}
+
+func TestNullAmount_JSON(t *testing.T) {
+	tests := []struct {
+		n    NullAmount
+		want string
+	}{
+		{NullAmount{}, `null`},
+		{NullAmount{Amount: MustParseAmount("USD", "12.34"), Valid: true}, `{"amount":"12.34","currency":"USD"}`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.n)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", tt.n, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", tt.n, got, tt.want)
+		}
+		n := NullAmount{Amount: MustParseAmount("EUR", "1"), Valid: true}
+		err = json.Unmarshal(got, &n)
+		if err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", got, err)
+			continue
+		}
+		if n != tt.n {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", got, n, tt.n)
+		}
+	}
+
+	t.Run("error", func(t *testing.T) {
+		data := []byte(`{"amount":"12.34","currency":"UUU"}`)
+		var n NullAmount
+		if err := json.Unmarshal(data, &n); err == nil || n.Valid {
+			t.Errorf("json.Unmarshal(%s) = %v, %v, want error and invalid amount", data, n, err)
+		}
+		n = NullAmount{}
+		if err := n.UnmarshalBSONValue(2, bsonString("USD 12.34")); err == nil || n.Valid {
+			t.Errorf("UnmarshalBSONValue(2, %q) = %v, %v, want error and invalid amount", "USD 12.34", n, err)
+		}
+	})
+}
+
+func TestTextAmount_JSON(t *testing.T) {
+	tests := []struct {
+		m, d, want string
+	}{
+		{"USD", "12.34", `"USD 12.34"`},
+		{"USD", "-12.3", `"USD -12.30"`},
+		{"JPY", "1", `"JPY 1"`},
+	}
+	for _, tt := range tests {
+		a := TextAmount{Amount: MustParseAmount(tt.m, tt.d)}
+		got, err := json.Marshal(a)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", a, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", a, got, tt.want)
+		}
+		var b TextAmount
+		err = json.Unmarshal(got, &b)
+		if err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", got, b, a)
+		}
+	}
+}
+
+func TestMinorUnits_JSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := MinorUnits{Curr: USD, Units: 1234}
+		got, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) failed: %v", m, err)
+		}
+		want := `{"currency":"USD","minor_units":1234}`
+		if string(got) != want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", m, got, want)
+		}
+		var n MinorUnits
+		err = json.Unmarshal(got, &n)
+		if err != nil {
+			t.Fatalf("json.Unmarshal(%s) failed: %v", got, err)
+		}
+		if n != m {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", got, n, m)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`{"currency":"UUU","minor_units":1234}`,
+			`{"currency":"USD","minor_units":12.34}`,
+			`{"currency":"USD","minor_units":"1234"}`,
+		}
+		for _, tt := range tests {
+			var got MinorUnits
+			err := json.Unmarshal([]byte(tt), &got)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestAmount_Percent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d, p            string
+			mode               RoundingMode
+			wantPart, wantRest string
+		}{
+			{"USD", "10.00", "2.9", HalfEven, "0.29", "9.71"},
+			{"USD", "10.00", "15", HalfEven, "1.50", "8.50"},
+			{"USD", "0.05", "50", HalfEven, "0.02", "0.03"},
+			{"USD", "0.05", "50", HalfUp, "0.03", "0.02"},
+			{"USD", "0.05", "50", Ceiling, "0.03", "0.02"},
+			{"USD", "-10.00", "2.9", HalfEven, "-0.29", "-9.71"},
+			{"USD", "-0.05", "50", Floor, "-0.03", "-0.02"},
+			{"USD", "123.45", "0", HalfEven, "0.00", "123.45"},
+			{"USD", "10.00", "100", HalfEven, "10.00", "0.00"},
+			{"USD", "10.00", "150", HalfEven, "15.00", "-5.00"},
+			{"USD", "10.00", "-10", HalfEven, "-1.00", "11.00"},
+			{"USD", "10.005", "10", HalfEven, "1.00", "9.005"},
+			{"JPY", "1000", "8", HalfEven, "80", "920"},
+			{"OMR", "1.000", "0.05", HalfEven, "0.000", "1.000"},
+			{"OMR", "1.000", "0.05", Ceiling, "0.001", "0.999"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			p := decimal.MustParse(tt.p)
+			gotPart, gotRest, err := a.Percent(p, tt.mode)
+			if err != nil {
+				t.Errorf("%q.Percent(%v, %v) failed: %v", a, p, tt.mode, err)
+				continue
+			}
+			wantPart := MustParseAmount(tt.m, tt.wantPart)
+			wantRest := MustParseAmount(tt.m, tt.wantRest)
+			if gotPart != wantPart || gotRest != wantRest {
+				t.Errorf("%q.Percent(%v, %v) = (%q, %q), want (%q, %q)", a, p, tt.mode, gotPart, gotRest, wantPart, wantRest)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			m, d, p string
+		}{
+			{"USD", "99999999999999999.99", "1000"},
+			{"JPY", "9999999999999999999", "200"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			p := decimal.MustParse(tt.p)
+			_, _, err := a.Percent(p)
+			if err == nil {
+				t.Errorf("%q.Percent(%v) did not fail", a, p)
+			}
+		}
+	})
+}
+
+func TestAmount_Bps(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d               string
+			n                  int64
+			mode               RoundingMode
+			wantPart, wantRest string
+		}{
+			{"USD", "1000.00", 25, HalfEven, "2.50", "997.50"},
+			{"USD", "1000.00", 10_000, HalfEven, "1000.00", "0.00"},
+			{"USD", "1.00", 1, HalfEven, "0.00", "1.00"},
+			{"USD", "1.00", 1, Ceiling, "0.01", "0.99"},
+			{"USD", "-1.00", 1, Floor, "-0.01", "-0.99"},
+			{"USD", "123.45", 0, HalfEven, "0.00", "123.45"},
+			{"JPY", "12345", 290, HalfUp, "358", "11987"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			gotPart, gotRest, err := a.Bps(tt.n, tt.mode)
+			if err != nil {
+				t.Errorf("%q.Bps(%v, %v) failed: %v", a, tt.n, tt.mode, err)
+				continue
+			}
+			wantPart := MustParseAmount(tt.m, tt.wantPart)
+			wantRest := MustParseAmount(tt.m, tt.wantRest)
+			if gotPart != wantPart || gotRest != wantRest {
+				t.Errorf("%q.Bps(%v, %v) = (%q, %q), want (%q, %q)", a, tt.n, tt.mode, gotPart, gotRest, wantPart, wantRest)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "99999999999999999.99")
+		_, _, err := a.Bps(math.MaxInt64)
+		if err == nil {
+			t.Errorf("%q.Bps(%v) did not fail", a, int64(math.MaxInt64))
+		}
+	})
+}
+
+func TestAmount_Key(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"USD 1.00", "USD 1.00", true},
+		{"USD 1.0", "USD 1.00", true},
+		{"USD 1", "USD 1.000000", true},
+		{"USD 0", "USD 0.00", true},
+		{"USD -0.50", "USD -0.5000", true},
+		{"USD 1.00", "USD 1.01", false},
+		{"USD 1.00", "USD -1.00", false},
+		{"USD 1.00", "EUR 1.00", false},
+		{"JPY 100", "USD 100.00", false},
+	}
+	for _, tt := range tests {
+		a, b := MustParseAmount(tt.a[:3], tt.a[4:]), MustParseAmount(tt.b[:3], tt.b[4:])
+		if got := a.Key() == b.Key(); got != tt.want {
+			t.Errorf("%q.Key() == %q.Key() = %v, want %v", a, b, got, tt.want)
+		}
+		if got := a.Hash() == b.Hash(); got != tt.want {
+			t.Errorf("%q.Hash() == %q.Hash() = %v, want %v", a, b, got, tt.want)
+		}
+		if got := bytes.Equal(a.AppendCanonical(nil), b.AppendCanonical(nil)); got != tt.want {
+			t.Errorf("%q.AppendCanonical() == %q.AppendCanonical() = %v, want %v", a, b, got, tt.want)
+		}
+		// Round trip
+		if got, want := a.Key().Amount(), a.TrimToCurr(); got != want {
+			t.Errorf("%q.Key().Amount() = %q, want %q", a, got, want)
+		}
+	}
+
+	t.Run("map", func(t *testing.T) {
+		seen := make(map[AmountKey]int)
+		for _, s := range []string{"10", "10.0", "10.00", "10.01", "-10"} {
+			seen[MustParseAmount("USD", s).Key()]++
+		}
+		if got, want := len(seen), 3; got != want {
+			t.Errorf("len(seen) = %v, want %v", got, want)
+		}
+		if got, want := seen[MustParseAmount("USD", "10").Key()], 3; got != want {
+			t.Errorf("seen[%q] = %v, want %v", "USD 10", got, want)
+		}
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		if got, want := (AmountKey{}).Amount(), (Amount{}); got != want {
+			t.Errorf("AmountKey{}.Amount() = %q, want %q", got, want)
+		}
+		if got, want := (Amount{}).Key(), (AmountKey{}); got != want {
+			t.Errorf("Amount{}.Key() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestAmount_AppendCanonical(t *testing.T) {
+	tests := []struct {
+		curr, amount string
+		want         []byte
+	}{
+		{"USD", "12.340", []byte{'U', 'S', 'D', 0, 0, 0, 0, 0, 0, 0, 0x04, 0xd2, 2}},
+		{"USD", "-12.34", []byte{'U', 'S', 'D', 1, 0, 0, 0, 0, 0, 0, 0x04, 0xd2, 2}},
+		{"JPY", "1200", []byte{'J', 'P', 'Y', 0, 0, 0, 0, 0, 0, 0, 0x04, 0xb0, 0}},
+		{"OMR", "0.000", []byte{'O', 'M', 'R', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.amount)
+		got := a.AppendCanonical([]byte("prefix"))
+		want := append([]byte("prefix"), tt.want...)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q.AppendCanonical(%q) = %v, want %v", a, "prefix", got, want)
+		}
+	}
+}
+
+func TestAmount_Hash(t *testing.T) {
+	a := MustParseAmount("USD", "12.34")
+	// The hash must be stable across processes and releases
+	if got, want := a.Hash(), fnv64a(a.AppendCanonical(nil)); got != want {
+		t.Errorf("%q.Hash() = %x, want %x", a, got, want)
+	}
+	if n := testing.AllocsPerRun(100, func() { intSink = int(a.Hash()) }); n != 0 { //nolint:gosec
+		t.Errorf("%q.Hash() allocated %v times, want 0", a, n)
+	}
+}
+
+func TestAmount_Generate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var neg, pos int
+	for _, size := range []int{0, 1, 5, 50} {
+		for range 1_000 {
+			v := Amount{}.Generate(r, size)
+			a, ok := v.Interface().(Amount)
+			if !ok {
+				t.Fatalf("Generate(%v) = %v, want Amount", size, v.Type())
+			}
+			if a.Curr() == XXX || a.Curr().Code() == "" {
+				t.Fatalf("Generate(%v) = %v, want a defined currency", size, a)
+			}
+			if !a.SameScaleAsCurr() {
+				t.Fatalf("Generate(%v) = %v, want scale %v", size, a, a.Curr().Scale())
+			}
+			if p := a.Decimal().Prec(); p > max(size, 1) || p > generateMaxPrec {
+				t.Fatalf("Generate(%v) = %v, has %v digits", size, a, p)
+			}
+			if a.IsNeg() {
+				neg++
+			} else if a.IsPos() {
+				pos++
+			}
+		}
+	}
+	if neg == 0 || pos == 0 {
+		t.Errorf("Generate() returned %v negative and %v positive amounts, want both", neg, pos)
+	}
+}
+
+// fnv64a computes the 64-bit FNV-1a hash of data as defined by the specification.
+func fnv64a(data []byte) uint64 {
+	h := uint64(14695981039346656037)
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= 1099511628211
+	}
+	return h
+}
+
+var (
+	amountSink Amount
+	intSink    int
+	stringSink string
+)
+
+func BenchmarkAmount_Add(b *testing.B) {
+	x := MustParseAmount("USD", "1234.56")
+	y := MustParseAmount("USD", "0.01")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		amountSink, _ = x.Add(y)
+	}
+}
+
+func BenchmarkAmount_Sub(b *testing.B) {
+	x := MustParseAmount("USD", "1234.56")
+	y := MustParseAmount("USD", "0.01")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		amountSink, _ = x.Sub(y)
+	}
+}
+
+func BenchmarkAmount_Cmp(b *testing.B) {
+	x := MustParseAmount("USD", "1234.56")
+	y := MustParseAmount("USD", "1234.57")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink, _ = x.Cmp(y)
+	}
+}
+
+func BenchmarkAmount_MulRound(b *testing.B) {
+	x := MustParseAmount("USD", "1234.56")
+	e := decimal.MustParse("0.075")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		amountSink, _ = x.MulRound(e)
+	}
+}
+
+func BenchmarkAmount_String(b *testing.B) {
+	x := MustParseAmount("USD", "-1234.56")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stringSink = x.String()
+	}
+}
+
+func BenchmarkAmount_AppendText(b *testing.B) {
+	x := MustParseAmount("USD", "-1234.56")
+	text := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		textSink, _ = x.AppendText(text[:0])
+	}
+}
+
+func BenchmarkAmount_Key(b *testing.B) {
+	x := MustParseAmount("USD", "-1234.560")
+	m := map[AmountKey]int{x.Key(): 1}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink = m[x.Key()]
+	}
+}
+
+func BenchmarkAmount_Hash(b *testing.B) {
+	x := MustParseAmount("USD", "-1234.56")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink = int(x.Hash()) //nolint:gosec
+	}
+}
+
+func BenchmarkAmount_MinorUnits(b *testing.B) {
+	x := MustParseAmount("USD", "-1234.567")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		units, _ := x.MinorUnits()
+		intSink = int(units)
+	}
+}
+
+func BenchmarkNewAmountFromMinorUnits(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		amountSink, _ = NewAmountFromMinorUnits("USD", -123456)
+	}
+}
+
+func BenchmarkParseAmount(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		amountSink, _ = ParseAmount("USD", "-1234.56")
+	}
+}
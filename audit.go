@@ -0,0 +1,214 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Op represents an operation of a [Calc] recorded in a [Step].
+type Op int8
+
+const (
+	OpAdd        Op = iota // [Calc.Add]
+	OpSub                  // [Calc.Sub]
+	OpMul                  // [Calc.Mul]
+	OpQuo                  // [Calc.Quo]
+	OpMulRatio             // [Calc.MulRatio]
+	OpAddPercent           // [Calc.AddPercent]
+	OpRound                // rounding of the result by [Calc.Audit]
+)
+
+// String implements the [fmt.Stringer] interface and returns the name of
+// the operation.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (o Op) String() string {
+	switch o {
+	case OpAdd:
+		return "Add"
+	case OpSub:
+		return "Sub"
+	case OpMul:
+		return "Mul"
+	case OpQuo:
+		return "Quo"
+	case OpMulRatio:
+		return "MulRatio"
+	case OpAddPercent:
+		return "AddPercent"
+	case OpRound:
+		return "Round"
+	default:
+		return fmt.Sprintf("Op(%d)", int8(o))
+	}
+}
+
+// Step is a record of a single operation of a [Calc], with its operand and
+// the intermediate results before and after it.
+// Only the operand fields of the operation are set, the others are zero.
+// Steps can be stored, for example as JSON, to explain how a result was
+// obtained, and verified later with [Replay].
+type Step struct {
+	Op       Op              // Operation
+	Amount   Amount          // Operand of OpAdd and OpSub
+	Factor   decimal.Decimal // Operand of OpMul, OpQuo, and OpAddPercent
+	Num, Den int64           // Operands of OpMulRatio
+	Mode     RoundingMode    // Rounding mode of OpRound
+	Before   Amount          // Intermediate result before the operation
+	After    Amount          // Intermediate result after the operation
+	Residual Amount          // Before minus After for OpRound, zero otherwise
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the step as an equation, for example
+// "USD 10.00 * 3 = USD 30.00" or
+// "USD 3.335 rounded HalfEven = USD 3.34, residual USD -0.005".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s Step) String() string {
+	switch s.Op {
+	case OpAdd:
+		return fmt.Sprintf("%v + %v = %v", s.Before, s.Amount, s.After)
+	case OpSub:
+		return fmt.Sprintf("%v - %v = %v", s.Before, s.Amount, s.After)
+	case OpMul:
+		return fmt.Sprintf("%v * %v = %v", s.Before, s.Factor, s.After)
+	case OpQuo:
+		return fmt.Sprintf("%v / %v = %v", s.Before, s.Factor, s.After)
+	case OpMulRatio:
+		return fmt.Sprintf("%v * %v / %v = %v", s.Before, s.Num, s.Den, s.After)
+	case OpAddPercent:
+		return fmt.Sprintf("%v + %v%% = %v", s.Before, s.Factor, s.After)
+	case OpRound:
+		return fmt.Sprintf("%v rounded %v = %v, residual %v", s.Before, s.Mode, s.After, s.Residual)
+	default:
+		return fmt.Sprintf("%v %v = %v", s.Before, s.Op, s.After)
+	}
+}
+
+// apply performs the operation of the step on amount a.
+func (s Step) apply(a Amount) (Amount, error) {
+	c := NewCalc(a)
+	switch s.Op {
+	case OpAdd:
+		c = c.Add(s.Amount)
+	case OpSub:
+		c = c.Sub(s.Amount)
+	case OpMul:
+		c = c.Mul(s.Factor)
+	case OpQuo:
+		c = c.Quo(s.Factor)
+	case OpMulRatio:
+		c = c.MulRatio(s.Num, s.Den)
+	case OpAddPercent:
+		c = c.AddPercent(s.Factor)
+	case OpRound:
+		return a.RoundWith(a.Curr().Scale(), s.Mode), nil
+	default:
+		return Amount{}, fmt.Errorf("unknown operation %v", s.Op)
+	}
+	return c.a, c.err
+}
+
+// trail is an immutable list of recorded steps, from the last to the first,
+// so that chains sharing a common prefix also share its steps.
+type trail struct {
+	step Step
+	prev *trail
+	n    int // number of steps in the list
+}
+
+// NewAuditedCalc returns a chain of operations starting with amount a,
+// like [NewCalc], that also records every operation, so that the result
+// can be explained step by step with [Calc.Audit].
+// Recording allocates memory for every operation, so chains that do not need
+// to be explained should use [NewCalc].
+func NewAuditedCalc(a Amount) Calc {
+	return Calc{a: a, audited: true}
+}
+
+// record adds the step to the trail if the chain is audited and the step
+// succeeded.
+// The result of the step is taken from the chain.
+func (c *Calc) record(s Step) {
+	if !c.audited || c.err != nil {
+		return
+	}
+	s.After = c.a
+	n := 1
+	if c.trail != nil {
+		n = c.trail.n + 1
+	}
+	c.trail = &trail{step: s, prev: c.trail, n: n}
+}
+
+// steps returns the recorded steps from the first to the last.
+func (c Calc) steps(extra int) []Step {
+	if c.trail == nil {
+		return make([]Step, 0, extra)
+	}
+	steps := make([]Step, c.trail.n, c.trail.n+extra)
+	for t := c.trail; t != nil; t = t.prev {
+		steps[t.n-1] = t.step
+	}
+	return steps
+}
+
+// Audit is like [Calc.Result], but also returns the recorded steps of
+// the chain, followed by the final rounding step with the residual removed
+// by rounding.
+// Only chains created by [NewAuditedCalc] record their steps, for other
+// chains the steps are nil.
+//
+// Audit returns the first error that occurred in the chain, along with
+// the steps that succeeded before it.
+func (c Calc) Audit(mode ...RoundingMode) (Amount, []Step, error) {
+	if !c.audited {
+		a, err := c.Result(mode...)
+		return a, nil, err
+	}
+	steps := c.steps(1)
+	if c.err != nil {
+		return Amount{}, steps, c.err
+	}
+	r := c.a.RoundWith(c.a.Curr().Scale(), mode...)
+	res, err := c.a.Sub(r)
+	if err != nil {
+		return Amount{}, steps, err
+	}
+	steps = append(steps, Step{Op: OpRound, Mode: roundingMode(mode), Before: c.a, After: r, Residual: res})
+	return r, steps, nil
+}
+
+// Replay performs the operations of the steps again, starting with the
+// amount before the first step, and verifies that every step produces
+// the recorded result.
+// Replay returns the result of the last step.
+// See also method [Calc.Audit].
+//
+// Replay returns an error if:
+//   - there are no steps;
+//   - a step starts from a different amount than the result of the previous
+//     step;
+//   - a step fails or produces a different result than the recorded one.
+func Replay(steps []Step) (Amount, error) {
+	if len(steps) == 0 {
+		return Amount{}, fmt.Errorf("replaying steps: no steps")
+	}
+	a := steps[0].Before
+	for i, s := range steps {
+		if s.Before != a {
+			return Amount{}, fmt.Errorf("replaying step %v [%v]: starts from %v, want %v", i, s, s.Before, a)
+		}
+		b, err := s.apply(a)
+		if err != nil {
+			return Amount{}, fmt.Errorf("replaying step %v [%v]: %w", i, s, err)
+		}
+		if b != s.After {
+			return Amount{}, fmt.Errorf("replaying step %v [%v]: result is %v", i, s, b)
+		}
+		a = b
+	}
+	return a, nil
+}
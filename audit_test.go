@@ -0,0 +1,162 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestCalc_Audit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		usd := func(s string) Amount { return MustParseAmount("USD", s) }
+		c := NewAuditedCalc(usd("19.99")).
+			Mul(decimal.MustParse("3")).
+			Add(usd("0.005")).
+			Sub(usd("1")).
+			Quo(decimal.MustParse("2")).
+			MulRatio(7, 100).
+			AddPercent(decimal.MustParse("-10"))
+		got, steps, err := c.Audit(HalfUp)
+		if err != nil {
+			t.Fatalf("Audit(HalfUp) failed: %v", err)
+		}
+		want, _ := c.Result(HalfUp)
+		if got != want {
+			t.Errorf("Audit(HalfUp) = %q, want %q", got, want)
+		}
+		wantOps := []Op{OpMul, OpAdd, OpSub, OpQuo, OpMulRatio, OpAddPercent, OpRound}
+		ops := make([]Op, len(steps))
+		for i, s := range steps {
+			ops[i] = s.Op
+		}
+		if !slices.Equal(ops, wantOps) {
+			t.Errorf("Audit(HalfUp) ops = %v, want %v", ops, wantOps)
+		}
+		wantStrings := []string{
+			"USD 19.99 * 3 = USD 59.97",
+			"USD 59.97 + USD 0.005 = USD 59.975",
+			"USD 59.975 - USD 1.00 = USD 58.975",
+			"USD 58.975 / 2 = USD 29.4875",
+			"USD 29.4875 * 7 / 100 = USD 2.064125",
+			"USD 2.064125 + -10% = USD 1.8577125",
+			"USD 1.8577125 rounded HalfUp = USD 1.86, residual USD -0.0022875",
+		}
+		for i, s := range steps {
+			if i < len(wantStrings) && s.String() != wantStrings[i] {
+				t.Errorf("steps[%v] = %q, want %q", i, s, wantStrings[i])
+			}
+		}
+		last := steps[len(steps)-1]
+		if sum, _ := last.After.Add(last.Residual); sum != last.Before {
+			t.Errorf("After + Residual = %q, want %q", sum, last.Before)
+		}
+
+		// Replay
+		replayed, err := Replay(steps)
+		if err != nil {
+			t.Errorf("Replay() failed: %v", err)
+		} else if replayed != got {
+			t.Errorf("Replay() = %q, want %q", replayed, got)
+		}
+
+		// Steps survive a JSON round trip
+		data, err := json.Marshal(steps)
+		if err != nil {
+			t.Fatalf("json.Marshal(steps) failed: %v", err)
+		}
+		var decoded []Step
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+		}
+		if !slices.Equal(decoded, steps) {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", data, decoded, steps)
+		}
+	})
+
+	t.Run("shared prefix", func(t *testing.T) {
+		base := NewAuditedCalc(MustParseAmount("USD", "10")).Mul(decimal.MustParse("2"))
+		a := base.AddPercent(decimal.MustParse("10"))
+		b := base.AddPercent(decimal.MustParse("20")).Add(MustParseAmount("USD", "1"))
+		_, stepsA, _ := a.Audit()
+		_, stepsB, _ := b.Audit()
+		_, stepsBase, _ := base.Audit()
+		if len(stepsBase) != 2 || len(stepsA) != 3 || len(stepsB) != 4 {
+			t.Errorf("len(steps) = %v, %v, %v, want 2, 3, 4", len(stepsBase), len(stepsA), len(stepsB))
+		}
+		if stepsA[1].Factor != decimal.MustParse("10") || stepsB[1].Factor != decimal.MustParse("20") {
+			t.Errorf("steps of shared chains = %v and %v", stepsA, stepsB)
+		}
+	})
+
+	t.Run("not audited", func(t *testing.T) {
+		got, steps, err := NewCalc(MustParseAmount("USD", "10")).MulRatio(1, 3).Audit()
+		if err != nil || steps != nil || got != MustParseAmount("USD", "3.33") {
+			t.Errorf("Audit() = %q, %v, %v, want USD 3.33 without steps", got, steps, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "10")
+		b := MustParseAmount("EUR", "10")
+		_, steps, err := NewAuditedCalc(a).Mul(decimal.MustParse("2")).Add(b).Add(a).Audit()
+		if !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Audit() = %v, want %v", err, ErrCurrencyMismatch)
+		}
+		if len(steps) != 1 || steps[0].Op != OpMul {
+			t.Errorf("Audit() steps = %v, want steps before the error", steps)
+		}
+	})
+}
+
+func TestReplay(t *testing.T) {
+	usd := func(s string) Amount { return MustParseAmount("USD", s) }
+	_, steps, err := NewAuditedCalc(usd("10")).MulRatio(1, 3).Audit()
+	if err != nil {
+		t.Fatalf("Audit() failed: %v", err)
+	}
+	tampered := func(f func(s []Step)) []Step {
+		s := slices.Clone(steps)
+		f(s)
+		return s
+	}
+	tests := map[string][]Step{
+		"empty":         nil,
+		"result":        tampered(func(s []Step) { s[0].After = usd("3.34") }),
+		"operand":       tampered(func(s []Step) { s[0].Den = 4 }),
+		"start":         tampered(func(s []Step) { s[1].Before = usd("3.33") }),
+		"rounding mode": tampered(func(s []Step) { s[1].Mode = Ceiling }),
+		"unknown op":    tampered(func(s []Step) { s[0].Op = Op(42) }),
+		"failing op":    tampered(func(s []Step) { s[0].Den = 0 }),
+	}
+	for name, steps := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Replay(steps); err == nil {
+				t.Errorf("Replay(%v) did not fail", steps)
+			}
+		})
+	}
+}
+
+func TestOp_String(t *testing.T) {
+	tests := []struct {
+		o    Op
+		want string
+	}{
+		{OpAdd, "Add"},
+		{OpSub, "Sub"},
+		{OpMul, "Mul"},
+		{OpQuo, "Quo"},
+		{OpMulRatio, "MulRatio"},
+		{OpAddPercent, "AddPercent"},
+		{OpRound, "Round"},
+		{Op(42), "Op(42)"},
+	}
+	for _, tt := range tests {
+		if got := tt.o.String(); got != tt.want {
+			t.Errorf("Op(%d).String() = %q, want %q", int8(tt.o), got, tt.want)
+		}
+	}
+}
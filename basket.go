@@ -0,0 +1,224 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/govalues/decimal"
+)
+
+// Basket represents balances in several currencies at once, such as the
+// contents of a wallet or the totals of a multi-currency ledger.
+// A basket holds at most one amount per currency, and its amounts are always
+// ordered by currency code.
+// A basket is immutable: methods that change balances return a new basket.
+// The zero value is an empty basket.
+type Basket struct {
+	amounts []Amount
+}
+
+// NewBasket returns a basket with the given amounts.
+// Amounts denominated in the same currency are added up.
+// See also method [Basket.Add].
+//
+// NewBasket returns an error if the sum of amounts in any currency overflows.
+func NewBasket(amounts ...Amount) (Basket, error) {
+	return Basket{}.Add(amounts...)
+}
+
+// MustNewBasket is like [NewBasket] but panics if the basket cannot be created.
+// This function simplifies safe initialization of global variables holding baskets.
+func MustNewBasket(amounts ...Amount) Basket {
+	b, err := NewBasket(amounts...)
+	if err != nil {
+		panic(fmt.Sprintf("NewBasket(%v) failed: %v", amounts, err))
+	}
+	return b
+}
+
+// Len returns the number of currencies in the basket.
+func (b Basket) Len() int {
+	return len(b.amounts)
+}
+
+// Currs returns the currencies of the basket ordered by currency code.
+// Currencies with zero balances are included.
+func (b Basket) Currs() []Currency {
+	currs := make([]Currency, len(b.amounts))
+	for i, a := range b.amounts {
+		currs[i] = a.Curr()
+	}
+	return currs
+}
+
+// Amounts returns the amounts of the basket ordered by currency code.
+// Modifying the returned slice does not change the basket.
+func (b Basket) Amounts() []Amount {
+	amounts := make([]Amount, len(b.amounts))
+	copy(amounts, b.amounts)
+	return amounts
+}
+
+// Amount returns the balance of the basket in the given currency.
+// If the basket has no balance in the currency, a zero amount with
+// the scale of the currency is returned.
+func (b Basket) Amount(curr Currency) Amount {
+	if i, ok := b.search(curr); ok {
+		return b.amounts[i]
+	}
+	return newAmountUnsafe(curr, decimal.Zero.Pad(curr.Scale()))
+}
+
+// search returns the position of the currency in the basket, and true if
+// the basket has a balance in this currency.
+func (b Basket) search(curr Currency) (int, bool) {
+	code := curr.Code()
+	i := sort.Search(len(b.amounts), func(i int) bool {
+		return b.amounts[i].Curr().Code() >= code
+	})
+	return i, i < len(b.amounts) && b.amounts[i].Curr() == curr
+}
+
+// IsZero returns true if all balances of the basket are zero.
+// An empty basket is also considered zero.
+func (b Basket) IsZero() bool {
+	for _, a := range b.amounts {
+		if !a.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns a basket with the given amounts added to the balances
+// in their currencies.
+//
+// Add returns an error if the integer part of any balance has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (b Basket) Add(amounts ...Amount) (Basket, error) {
+	c, err := b.add(amounts, false)
+	if err != nil {
+		return Basket{}, fmt.Errorf("computing [%v + %v]: %w", b, amounts, err)
+	}
+	return c, nil
+}
+
+// Sub returns a basket with the given amounts subtracted from the balances
+// in their currencies.
+// Balances can become negative, and zero balances are kept in the basket.
+//
+// Sub returns an error if the integer part of any balance has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (b Basket) Sub(amounts ...Amount) (Basket, error) {
+	c, err := b.add(amounts, true)
+	if err != nil {
+		return Basket{}, fmt.Errorf("computing [%v - %v]: %w", b, amounts, err)
+	}
+	return c, nil
+}
+
+func (b Basket) add(amounts []Amount, neg bool) (Basket, error) {
+	c := Basket{amounts: b.Amounts()}
+	for _, a := range amounts {
+		if neg {
+			a = a.Neg()
+		}
+		i, ok := c.search(a.Curr())
+		if !ok {
+			c.amounts = append(c.amounts, Amount{})
+			copy(c.amounts[i+1:], c.amounts[i:])
+			c.amounts[i] = a
+			continue
+		}
+		s, err := c.amounts[i].add(a)
+		if err != nil {
+			return Basket{}, err
+		}
+		c.amounts[i] = s
+	}
+	return c, nil
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the basket, for example "[EUR 5.00, USD 1.00]".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (b Basket) String() string {
+	text := make([]byte, 0, 16*len(b.amounts)+2)
+	text = append(text, '[')
+	for i, a := range b.amounts {
+		if i > 0 {
+			text = append(text, ", "...)
+		}
+		text = a.append(text)
+	}
+	text = append(text, ']')
+	return string(text)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The basket must be an object that maps currency codes to amounts,
+// represented as strings or numbers, for example {"EUR":"5.00","USD":1.00}.
+// Each currency can be specified only once, so {"USD":"1.00","usd":"2.00"}
+// is rejected.
+// See also constructor [NewBasket].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (b *Basket) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var err error
+	var v map[string]json.Number
+	if err = json.Unmarshal(data, &v); err == nil {
+		*b, err = parseBasket(v)
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Basket{}, err)
+	}
+	return nil
+}
+
+// parseBasket converts an object that maps currency codes to amounts to
+// a basket.
+// Keys that denote the same currency, such as "USD", "usd", and "840",
+// are rejected rather than summed.
+func parseBasket(v map[string]json.Number) (Basket, error) {
+	amounts := make([]Amount, 0, len(v))
+	seen := make(map[Currency]bool, len(v))
+	for curr, amount := range v {
+		a, err := ParseAmount(curr, string(amount))
+		if err != nil {
+			return Basket{}, err
+		}
+		if seen[a.Curr()] {
+			return Basket{}, fmt.Errorf("currency %v is specified more than once", a.Curr())
+		}
+		seen[a.Curr()] = true
+		amounts = append(amounts, a)
+	}
+	return NewBasket(amounts...)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// MarshalJSON always returns an object that maps currency codes to amounts
+// as strings, ordered by currency code, for example {"EUR":"5.00","USD":"1.00"}.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (b Basket) MarshalJSON() ([]byte, error) {
+	text := make([]byte, 0, 24*len(b.amounts)+2)
+	text = append(text, '{')
+	for i, a := range b.amounts {
+		if i > 0 {
+			text = append(text, ',')
+		}
+		text = append(text, '"')
+		text = append(text, a.Curr().Code()...)
+		text = append(text, `":"`...)
+		text, _ = a.Decimal().AppendText(text) // Decimal.AppendText is always successful
+		text = append(text, '"')
+	}
+	text = append(text, '}')
+	return text, nil
+}
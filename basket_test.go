@@ -0,0 +1,221 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBasket_ZeroValue(t *testing.T) {
+	var b Basket
+	if got := b.Len(); got != 0 {
+		t.Errorf("Basket{}.Len() = %v, want 0", got)
+	}
+	if !b.IsZero() {
+		t.Errorf("Basket{}.IsZero() = false, want true")
+	}
+	if got, want := b.String(), "[]"; got != want {
+		t.Errorf("Basket{}.String() = %q, want %q", got, want)
+	}
+	if got, want := b.Amount(USD), MustParseAmount("USD", "0.00"); got != want {
+		t.Errorf("Basket{}.Amount(USD) = %q, want %q", got, want)
+	}
+}
+
+func TestNewBasket(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts []string
+			want    string
+		}{
+			{[]string{}, "[]"},
+			{[]string{"USD 1.00"}, "[USD 1.00]"},
+			{[]string{"USD 1.00", "EUR 2.00"}, "[EUR 2.00, USD 1.00]"},
+			{[]string{"USD 1.00", "EUR 2.00", "USD 3.00"}, "[EUR 2.00, USD 4.00]"},
+			{[]string{"USD 1.00", "USD -1.00"}, "[USD 0.00]"},
+			{[]string{"OMR 1.000", "JPY 1", "USD 1.00", "CHF 1.00"}, "[CHF 1.00, JPY 1, OMR 1.000, USD 1.00]"},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			for i, s := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, s)
+			}
+			got, err := NewBasket(amounts...)
+			if err != nil {
+				t.Errorf("NewBasket(%v) failed: %v", amounts, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewBasket(%v) = %v, want %v", amounts, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "99999999999999999")
+		_, err := NewBasket(a, a)
+		if err == nil {
+			t.Errorf("NewBasket(%q, %q) did not fail", a, a)
+		}
+	})
+}
+
+func mustParseSQLAmount(t *testing.T, s string) Amount {
+	t.Helper()
+	a, err := parseSQLAmount(s)
+	if err != nil {
+		t.Fatalf("parseSQLAmount(%q) failed: %v", s, err)
+	}
+	return a
+}
+
+func TestBasket_Add(t *testing.T) {
+	b := MustNewBasket(MustParseAmount("USD", "1.00"), MustParseAmount("EUR", "2.00"))
+	got, err := b.Add(MustParseAmount("USD", "0.50"), MustParseAmount("GBP", "3"))
+	if err != nil {
+		t.Fatalf("%v.Add() failed: %v", b, err)
+	}
+	if want := "[EUR 2.00, GBP 3.00, USD 1.50]"; got.String() != want {
+		t.Errorf("%v.Add() = %v, want %v", b, got, want)
+	}
+	if want := "[EUR 2.00, USD 1.00]"; b.String() != want {
+		t.Errorf("Add modified the original basket: %v, want %v", b, want)
+	}
+
+	a := MustParseAmount("USD", "99999999999999999")
+	_, err = b.Add(a, a)
+	if err == nil {
+		t.Errorf("%v.Add(%q, %q) did not fail", b, a, a)
+	}
+}
+
+func TestBasket_Sub(t *testing.T) {
+	b := MustNewBasket(MustParseAmount("USD", "1.00"), MustParseAmount("EUR", "2.00"))
+	got, err := b.Sub(MustParseAmount("USD", "1.00"), MustParseAmount("GBP", "3"))
+	if err != nil {
+		t.Fatalf("%v.Sub() failed: %v", b, err)
+	}
+	if want := "[EUR 2.00, GBP -3.00, USD 0.00]"; got.String() != want {
+		t.Errorf("%v.Sub() = %v, want %v", b, got, want)
+	}
+
+	a := MustParseAmount("USD", "-99999999999999999")
+	_, err = b.Sub(a, a)
+	if err == nil {
+		t.Errorf("%v.Sub(%q, %q) did not fail", b, a, a)
+	}
+}
+
+func TestBasket_IsZero(t *testing.T) {
+	tests := []struct {
+		amounts []Amount
+		want    bool
+	}{
+		{nil, true},
+		{[]Amount{MustParseAmount("USD", "0")}, true},
+		{[]Amount{MustParseAmount("USD", "0"), MustParseAmount("EUR", "0")}, true},
+		{[]Amount{MustParseAmount("USD", "0"), MustParseAmount("EUR", "0.01")}, false},
+		{[]Amount{MustParseAmount("USD", "-0.01")}, false},
+	}
+	for _, tt := range tests {
+		b := MustNewBasket(tt.amounts...)
+		if got := b.IsZero(); got != tt.want {
+			t.Errorf("%v.IsZero() = %t, want %t", b, got, tt.want)
+		}
+	}
+}
+
+func TestBasket_Currs(t *testing.T) {
+	b := MustNewBasket(
+		MustParseAmount("USD", "1"),
+		MustParseAmount("EUR", "0"),
+		MustParseAmount("JPY", "1"),
+	)
+	got := b.Currs()
+	want := []Currency{EUR, JPY, USD}
+	if len(got) != len(want) {
+		t.Fatalf("%v.Currs() = %v, want %v", b, got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("%v.Currs() = %v, want %v", b, got, want)
+		}
+	}
+}
+
+func TestBasket_Amounts(t *testing.T) {
+	b := MustNewBasket(MustParseAmount("USD", "1.00"), MustParseAmount("EUR", "2.00"))
+	got := b.Amounts()
+	got[0] = MustParseAmount("EUR", "9.99")
+	if want := "[EUR 2.00, USD 1.00]"; b.String() != want {
+		t.Errorf("modifying Amounts changed the basket: %v, want %v", b, want)
+	}
+	if got, want := b.Amount(EUR), MustParseAmount("EUR", "2.00"); got != want {
+		t.Errorf("%v.Amount(EUR) = %q, want %q", b, got, want)
+	}
+	if got, want := b.Amount(JPY), MustParseAmount("JPY", "0"); got != want {
+		t.Errorf("%v.Amount(JPY) = %q, want %q", b, got, want)
+	}
+}
+
+func TestBasket_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		amounts []Amount
+		want    string
+	}{
+		{nil, `{}`},
+		{[]Amount{MustParseAmount("USD", "1.00"), MustParseAmount("EUR", "2.50")}, `{"EUR":"2.50","USD":"1.00"}`},
+	}
+	for _, tt := range tests {
+		b := MustNewBasket(tt.amounts...)
+		got, err := json.Marshal(b)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", b, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", b, got, tt.want)
+		}
+	}
+}
+
+func TestBasket_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{`{}`, "[]"},
+			{`{"USD":"1.00","EUR":2.50}`, "[EUR 2.50, USD 1.00]"},
+			{`{"JPY":"1","OMR":"1"}`, "[JPY 1, OMR 1.000]"},
+		}
+		for _, tt := range tests {
+			var got Basket
+			err := json.Unmarshal([]byte(tt.s), &got)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`{"ABC":"1.00"}`,
+			`{"USD":"abc"}`,
+			`{"USD":true}`,
+			`["USD 1.00"]`,
+			`{"USD":"1.00","usd":"2.00"}`,
+			`{"USD":"1.00","840":"3.00"}`,
+			`{"USD":"1.00","usd":"2.00","840":"3.00"}`,
+		}
+		for _, s := range tests {
+			var got Basket
+			err := json.Unmarshal([]byte(s), &got)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", s)
+			}
+		}
+	})
+}
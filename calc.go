@@ -0,0 +1,157 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Calc represents a chain of arithmetic operations on an amount, such as
+// a price that is multiplied by a quantity, discounted, and taxed.
+// Intermediate results are not rounded to the scale of the currency: they
+// keep as many digits as fit into a [decimal.Decimal], and the final result
+// is rounded only once by [Calc.Result].
+// This avoids the accumulation of errors that happens when every step is
+// rounded with [Amount.MulRound] or [Amount.QuoRound].
+//
+// The first error stops the chain: all subsequent operations do nothing,
+// and the error is returned by [Calc.Result].
+// Calc is immutable: every operation returns a new chain, so a common
+// prefix of several calculations can be shared.
+// The zero value is a chain starting with "XXX 0".
+// See also constructor [NewAuditedCalc] for recording the operations.
+type Calc struct {
+	a       Amount
+	err     error
+	audited bool   // operations are recorded
+	trail   *trail // last recorded operation
+}
+
+// NewCalc returns a chain of operations starting with amount a.
+func NewCalc(a Amount) Calc {
+	return Calc{a: a}
+}
+
+// Add adds amount b to the intermediate result.
+// See also method [Amount.Add].
+func (c Calc) Add(b Amount) Calc {
+	if c.err != nil {
+		return c
+	}
+	before := c.a
+	c.a, c.err = c.a.Add(b)
+	c.record(Step{Op: OpAdd, Amount: b, Before: before})
+	return c
+}
+
+// Sub subtracts amount b from the intermediate result.
+// See also method [Amount.Sub].
+func (c Calc) Sub(b Amount) Calc {
+	if c.err != nil {
+		return c
+	}
+	before := c.a
+	c.a, c.err = c.a.Sub(b)
+	c.record(Step{Op: OpSub, Amount: b, Before: before})
+	return c
+}
+
+// Mul multiplies the intermediate result by factor e.
+// See also method [Amount.Mul].
+func (c Calc) Mul(e decimal.Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	before := c.a
+	c.a, c.err = c.a.Mul(e)
+	c.record(Step{Op: OpMul, Factor: e, Before: before})
+	return c
+}
+
+// Quo divides the intermediate result by divisor e.
+// See also method [Amount.Quo].
+func (c Calc) Quo(e decimal.Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	before := c.a
+	c.a, c.err = c.a.Quo(e)
+	c.record(Step{Op: OpQuo, Factor: e, Before: before})
+	return c
+}
+
+// MulRatio multiplies the intermediate result by the ratio num / den,
+// for example by 7 / 100 to take 7%, or by 1 / 3 to take a third.
+// The multiplication is performed before the division, so that ratios that
+// cannot be represented exactly as decimals, such as 1 / 3, lose as few
+// digits as possible.
+func (c Calc) MulRatio(num, den int64) Calc {
+	if c.err != nil {
+		return c
+	}
+	a, err := c.a.mulRatio(num, den)
+	if err != nil {
+		c.err = fmt.Errorf("computing [%v * %v / %v]: %w", c.a, num, den, err)
+		return c
+	}
+	before := c.a
+	c.a = a
+	c.record(Step{Op: OpMulRatio, Num: num, Den: den, Before: before})
+	return c
+}
+
+func (a Amount) mulRatio(num, den int64) (Amount, error) {
+	if den == 0 {
+		return Amount{}, errDivisionByZero
+	}
+	n, err := decimal.New(num, 0)
+	if err != nil {
+		return Amount{}, err
+	}
+	d, err := decimal.New(den, 0)
+	if err != nil {
+		return Amount{}, err
+	}
+	b, err := a.mul(n)
+	if err != nil {
+		return Amount{}, err
+	}
+	return b.quo(d)
+}
+
+// AddPercent increases the intermediate result by p percent, for example
+// by 2.5 to add a fee of 2.5%, or by -10 to apply a discount of 10%.
+func (c Calc) AddPercent(p decimal.Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	a, err := c.a.mul(p)
+	if err == nil {
+		a, err = a.quo(decimal.Hundred)
+	}
+	if err == nil {
+		a, err = c.a.add(a)
+	}
+	if err != nil {
+		c.err = fmt.Errorf("computing [%v + %v%%]: %w", c.a, p, err)
+		return c
+	}
+	before := c.a
+	c.a = a
+	c.record(Step{Op: OpAddPercent, Factor: p, Before: before})
+	return c
+}
+
+// Result returns the result of the chain rounded to the scale of its
+// currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also method [Amount.RoundWith].
+//
+// Result returns the first error that occurred in the chain.
+// See also method [Calc.Audit].
+func (c Calc) Result(mode ...RoundingMode) (Amount, error) {
+	if c.err != nil {
+		return Amount{}, c.err
+	}
+	return c.a.RoundWith(c.a.Curr().Scale(), mode...), nil
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestCalc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		usd := func(s string) Amount { return MustParseAmount("USD", s) }
+		tests := []struct {
+			calc Calc
+			mode RoundingMode
+			want Amount
+		}{
+			{NewCalc(usd("10")), HalfEven, usd("10.00")},
+			{NewCalc(usd("10")).MulRatio(1, 3).Mul(decimal.MustParse("3")), HalfEven, usd("10.00")},
+			{NewCalc(usd("10")).Quo(decimal.MustParse("3")).Mul(decimal.MustParse("3")), HalfEven, usd("10.00")},
+			{NewCalc(usd("19.99")).Mul(decimal.MustParse("3")).MulRatio(7, 100), HalfEven, usd("4.20")},
+			{NewCalc(usd("19.99")).Mul(decimal.MustParse("3")).MulRatio(7, 100), Floor, usd("4.19")},
+			{NewCalc(usd("100")).AddPercent(decimal.MustParse("2.5")), HalfEven, usd("102.50")},
+			{NewCalc(usd("100")).AddPercent(decimal.MustParse("-10")), HalfEven, usd("90.00")},
+			{NewCalc(usd("0.01")).AddPercent(decimal.MustParse("50")), HalfEven, usd("0.02")},
+			{NewCalc(usd("0.01")).AddPercent(decimal.MustParse("50")), HalfDown, usd("0.01")},
+			{NewCalc(usd("5")).Add(usd("0.005")).Sub(usd("0.001")), HalfEven, usd("5.00")},
+			{NewCalc(usd("5")).Add(usd("0.005")).Sub(usd("0.001")), Ceiling, usd("5.01")},
+			{NewCalc(usd("1")).MulRatio(-1, 8), HalfUp, usd("-0.13")},
+			{Calc{}, HalfEven, Amount{}},
+		}
+		for _, tt := range tests {
+			got, err := tt.calc.Result(tt.mode)
+			if err != nil {
+				t.Errorf("%v.Result(%v) failed: %v", tt.calc, tt.mode, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%v.Result(%v) = %q, want %q", tt.calc, tt.mode, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "10")
+		b := MustParseAmount("EUR", "10")
+		large := MustParseAmount("USD", "99999999999999999")
+		tests := map[string]struct {
+			calc Calc
+			want error
+		}{
+			"add mismatch":      {NewCalc(a).Add(b), ErrCurrencyMismatch},
+			"sub mismatch":      {NewCalc(a).Sub(b), ErrCurrencyMismatch},
+			"mul overflow":      {NewCalc(large).Mul(decimal.MustParse("10")), ErrOverflow},
+			"quo zero":          {NewCalc(a).Quo(decimal.Zero), nil},
+			"ratio zero":        {NewCalc(a).MulRatio(1, 0), errDivisionByZero},
+			"ratio overflow":    {NewCalc(large).MulRatio(100, 1), ErrOverflow},
+			"percent overflow":  {NewCalc(large).AddPercent(decimal.MustParse("100")), ErrOverflow},
+			"first error kept":  {NewCalc(a).Add(b).Mul(decimal.MustParse("2")).Quo(decimal.Zero).MulRatio(1, 0).AddPercent(decimal.One).Sub(b), ErrCurrencyMismatch},
+			"later error kept":  {NewCalc(a).Mul(decimal.MustParse("2")).Add(b).Add(a), ErrCurrencyMismatch},
+			"error after large": {NewCalc(large).Add(large), ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := tt.calc.Result()
+				if err == nil {
+					t.Fatalf("%v.Result() did not fail", tt.calc)
+				}
+				if tt.want != nil && !errors.Is(err, tt.want) {
+					t.Errorf("%v.Result() = %v, want %v", tt.calc, err, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("immutable", func(t *testing.T) {
+		base := NewCalc(MustParseAmount("USD", "100")).MulRatio(1, 3)
+		x, _ := base.Mul(decimal.MustParse("3")).Result()
+		y, _ := base.Result()
+		if want := MustParseAmount("USD", "100.00"); x != want {
+			t.Errorf("Result() = %q, want %q", x, want)
+		}
+		if want := MustParseAmount("USD", "33.33"); y != want {
+			t.Errorf("Result() = %q, want %q", y, want)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+// Code generated by scripts/currency/codegen.go. DO NOT EDIT.
+// Any changes made to this file will be overwritten the next time it is generated.
+
+package money
+
+import "github.com/govalues/decimal"
+
+var cashLookup = map[Currency]decimal.Decimal{
+	CAD: decimal.MustParse("0.05"),
+	CHF: decimal.MustParse("0.05"),
+	CRC: decimal.MustParse("1"),
+	CZK: decimal.MustParse("1"),
+	DKK: decimal.MustParse("0.50"),
+	HUF: decimal.MustParse("1"),
+	IDR: decimal.MustParse("1"),
+	MNT: decimal.MustParse("1"),
+	NOK: decimal.MustParse("1"),
+	PKR: decimal.MustParse("1"),
+	SEK: decimal.MustParse("1"),
+	TWD: decimal.MustParse("1"),
+	UZS: decimal.MustParse("1"),
+}
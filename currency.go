@@ -4,9 +4,16 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
 )
 
-//go:generate go run scripts/currency/codegen.go
+//go:generate go run scripts/currency/codegen.go -source=file
 
 // Currency type represents a currency in the global financial system.
 // The zero value is [XXX], which indicates an unknown currency.
@@ -14,7 +21,8 @@ import (
 // Currency is implemented as an integer index into an in-memory array that
 // stores properties defined by [ISO 4217], such as code and scale.
 // This design ensures safe concurrency for multiple goroutines accessing
-// the same Currency value.
+// the same Currency value, provided that currencies are only registered or
+// rescaled during program initialization, see [RegisterCurr].
 //
 // When persisting a currency value, use the alphabetic code returned by
 // the [Currency.Code] method, rather than the integer index, as mapping between
@@ -32,11 +40,12 @@ var errInvalidCurrency = errors.New("invalid currency")
 //	usd
 //	840
 //
-// ParseCurr returns an error if the string does not represent a valid currency code.
+// ParseCurr returns an [UnknownCurrencyError] if the string does not represent
+// a valid currency code.
 func ParseCurr(curr string) (Currency, error) {
 	c, ok := currLookup[curr]
 	if !ok {
-		return XXX, errInvalidCurrency
+		return XXX, &UnknownCurrencyError{Code: curr}
 	}
 	return c, nil
 }
@@ -51,6 +60,258 @@ func MustParseCurr(curr string) Currency {
 	return c
 }
 
+// CurrByNum returns the currency with the given [numeric code], for example
+// [USD] for 840 or [ALL] for 8.
+// It is useful for processing messages that carry numeric codes as integers,
+// such as ISO 8583 card network messages.
+// Numeric codes given as strings, such as "840" or "008", are accepted by
+// [ParseCurr].
+//
+// CurrByNum returns an [UnknownCurrencyError] if no currency has the numeric code.
+//
+// [numeric code]: https://en.wikipedia.org/wiki/ISO_4217#Numeric_codes
+func CurrByNum(num int) (Currency, error) {
+	code := fmt.Sprintf("%03d", num)
+	c, ok := currLookup[code]
+	if !ok {
+		return XXX, &UnknownCurrencyError{Code: code}
+	}
+	return c, nil
+}
+
+// CurrsForCountry returns the currencies in use in the country with the given
+// [ISO 3166] code, as defined by the [CLDR], for example [CHF], [CHE] and [CHW]
+// for "CH".
+// The code is case-insensitive.
+// Circulating currencies come before funds, and currencies of the same kind
+// are ordered by code.
+// If the country is unknown, the function returns nil.
+// See also method [Currency.Countries].
+//
+// [ISO 3166]: https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+// [CLDR]: https://cldr.unicode.org
+func CurrsForCountry(country string) []Currency {
+	country = strings.ToUpper(country)
+	var currs []Currency
+	for c, regions := range countryLookup {
+		if _, ok := slices.BinarySearch(regions, country); ok {
+			currs = append(currs, c)
+		}
+	}
+	slices.SortFunc(currs, func(c, d Currency) int {
+		if c.IsFund() != d.IsFund() {
+			if d.IsFund() {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(c.Code(), d.Code())
+	})
+	return currs
+}
+
+// AllCurrs returns all defined currencies: the ISO 4217 currencies,
+// in the same order as their constants, such as [XXX], [XTS], and [AED],
+// followed by the currencies added with [RegisterCurr] and
+// [RegisterHistoricalCurr], in the order of registration.
+// Each call returns a new slice that can be modified by the caller.
+//
+// The constants form an exhaustive set of values of the [Currency] type,
+// so linters such as [exhaustive] can check switch statements over them.
+//
+// [exhaustive]: https://github.com/nishanths/exhaustive
+func AllCurrs() []Currency {
+	currs := slices.Clone(currList[:])
+	for i := len(currList); i < len(codeLookup); i++ {
+		if codeLookup[i] != "" {
+			currs = append(currs, Currency(i)) //nolint:gosec
+		}
+	}
+	return currs
+}
+
+// registerMu serializes calls to [RegisterCurr] and [SetCurrScale].
+// It does not guard readers of the lookup tables, so registration must
+// complete before other goroutines start using currencies.
+var registerMu sync.Mutex
+
+// RegisterCurr defines a currency that is not part of the ISO 4217 standard,
+// such as a cryptocurrency or loyalty points.
+// Once registered, the currency works with parsing, formatting, encoding, and
+// arithmetic exactly like the currencies defined by ISO 4217.
+// The numeric code is optional and can be empty.
+// Amounts have 19 - scale digits for their integer part, so currencies with
+// large scales have small ranges: a scale of 8 allows 11 integer digits and
+// a scale of 18 allows only 1, that is, amounts up to 9.999999999999999999.
+// See the Constraints section of the package documentation for the ranges.
+//
+// RegisterCurr must be called during program initialization, for example
+// from an init function or a package-level variable declaration.
+// Registration at run time is not supported: calling RegisterCurr while other
+// goroutines are using currencies, for example calling [ParseCurr], is a data
+// race that can crash the program.
+// Up to 256 currencies, including the ISO 4217 ones, can be defined.
+//
+// RegisterCurr returns an error if:
+//   - the code does not consist of 3 uppercase ASCII letters;
+//   - the numeric code is not empty and does not consist of 3 ASCII digits;
+//   - the code or the numeric code is already used by another currency;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - no more currencies can be defined.
+func RegisterCurr(code, num string, scale int) (Currency, error) {
+	c, err := registerCurr(code, num, scale)
+	if err != nil {
+		return XXX, fmt.Errorf("registering currency %q: %w", code, err)
+	}
+	return c, nil
+}
+
+func registerCurr(code, num string, scale int) (Currency, error) {
+	// Codes
+	if len(code) != 3 || strings.IndexFunc(code, isNotUpper) >= 0 {
+		return XXX, fmt.Errorf("code must consist of 3 uppercase letters")
+	}
+	if num != "" && (len(num) != 3 || strings.IndexFunc(num, isNotDigit) >= 0) {
+		return XXX, fmt.Errorf("numeric code must consist of 3 digits")
+	}
+	// Scale
+	if scale < 0 || scale > decimal.MaxScale {
+		return XXX, fmt.Errorf("scale must be between 0 and %v", decimal.MaxScale)
+	}
+
+	registerMu.Lock()
+	defer registerMu.Unlock()
+
+	// Uniqueness
+	if _, ok := currLookup[code]; ok {
+		return XXX, fmt.Errorf("code is already used")
+	}
+	if _, ok := currLookup[num]; ok && num != "" {
+		return XXX, fmt.Errorf("numeric code %q is already used", num)
+	}
+
+	// Free index
+	for i, s := range codeLookup {
+		if s != "" {
+			continue
+		}
+		c := Currency(i) //nolint:gosec
+		codeLookup[c] = code
+		numLookup[c] = num
+		scaleLookup[c] = int8(scale) //nolint:gosec
+		currLookup[code] = c
+		currLookup[strings.ToLower(code)] = c
+		if num != "" {
+			currLookup[num] = c
+		}
+		return c, nil
+	}
+	return XXX, fmt.Errorf("too many currencies")
+}
+
+// MustRegisterCurr is like [RegisterCurr] but panics if the currency cannot be registered.
+// It simplifies safe initialization of global variables holding currencies.
+func MustRegisterCurr(code, num string, scale int) Currency {
+	c, err := RegisterCurr(code, num, scale)
+	if err != nil {
+		panic(fmt.Sprintf("RegisterCurr(%q, %q, %v) failed: %v", code, num, scale, err))
+	}
+	return c
+}
+
+// histCurr holds the properties of a withdrawn currency defined by
+// ISO 4217 list three.
+type histCurr struct {
+	num   string
+	scale int
+}
+
+// RegisterHistoricalCurr defines a currency that has been withdrawn from
+// circulation, such as the Deutsche Mark or the French Franc, using
+// its code, numeric code, and scale from [ISO 4217 list three].
+// It is useful for processing archival data, and works like [RegisterCurr].
+// If the numeric code of a withdrawn currency has been reassigned, the currency
+// is defined without a numeric code.
+// If the currency is already defined, it is returned as is.
+//
+// Like [RegisterCurr], RegisterHistoricalCurr must be called during program
+// initialization.
+//
+// RegisterHistoricalCurr returns an error if:
+//   - the code does not represent a withdrawn ISO 4217 currency;
+//   - no more currencies can be defined.
+//
+// [ISO 4217 list three]: https://www.six-group.com/en/products-services/financial-information/data-standards.html
+func RegisterHistoricalCurr(code string) (Currency, error) {
+	c, err := registerHistoricalCurr(code)
+	if err != nil {
+		return XXX, fmt.Errorf("registering historical currency %q: %w", code, err)
+	}
+	return c, nil
+}
+
+func registerHistoricalCurr(code string) (Currency, error) {
+	h, ok := histLookup[code]
+	if !ok {
+		return XXX, &UnknownCurrencyError{Code: code}
+	}
+	if c, ok := currLookup[code]; ok {
+		return c, nil
+	}
+	num := h.num
+	if _, ok := currLookup[num]; ok {
+		num = ""
+	}
+	return registerCurr(code, num, h.scale)
+}
+
+// MustRegisterHistoricalCurr is like [RegisterHistoricalCurr] but panics if
+// the currency cannot be registered.
+// It simplifies safe initialization of global variables holding currencies.
+func MustRegisterHistoricalCurr(code string) Currency {
+	c, err := RegisterHistoricalCurr(code)
+	if err != nil {
+		panic(fmt.Sprintf("RegisterHistoricalCurr(%q) failed: %v", code, err))
+	}
+	return c
+}
+
+// SetCurrScale overrides the scale of a currency for the whole program,
+// for example to account for US Dollar fuel prices in tenths of a cent
+// with a scale of 3, or to account for Japanese Yen interchange fees with
+// a scale of 2.
+// The new scale is used everywhere the scale of the currency is used:
+// by [Currency.Scale], parsing, rounding, allocation, and formatting.
+// To display amounts with a different scale than the one used for
+// accounting, use [Formatter.WithScale] instead.
+// Increasing the scale reduces the range of amounts in the currency, since
+// amounts have 19 - scale digits for their integer part.
+//
+// SetCurrScale must be called during program initialization,
+// before any amounts in the currency are created.
+// Calling it while other goroutines are using currencies is a data race.
+//
+// SetCurrScale returns an error if:
+//   - the currency is not defined, see [UnknownCurrencyError];
+//   - the scale is negative or greater than [decimal.MaxScale].
+func SetCurrScale(c Currency, scale int) error {
+	if c.Code() == "" {
+		return fmt.Errorf("setting scale of currency %v: %w", int(c), &UnknownCurrencyError{Code: strconv.Itoa(int(c))})
+	}
+	if scale < 0 || scale > decimal.MaxScale {
+		return fmt.Errorf("setting scale of currency %v: scale must be between 0 and %v", c, decimal.MaxScale)
+	}
+	registerMu.Lock()
+	defer registerMu.Unlock()
+	scaleLookup[c] = int8(scale) //nolint:gosec
+	return nil
+}
+
+// isNotUpper returns true if the rune is not an uppercase ASCII letter.
+func isNotUpper(r rune) bool {
+	return r < 'A' || 'Z' < r
+}
+
 // String method implements the [fmt.Stringer] interface and returns
 // a string representation of the Currency value.
 // See also method [Currency.Format].
@@ -348,8 +609,25 @@ func (c Currency) Scale() int {
 	return int(scaleLookup[c])
 }
 
+// CashUnit returns the smallest amount of the currency that can be paid in cash,
+// as defined by the [CLDR] currency data.
+// For example, the cash unit of the Swiss Franc is 0.05, the cash unit of
+// the Swedish Krona is 1, and the cash unit of the US Dollar is its minor
+// unit 0.01.
+// See also methods [Currency.Scale], [Amount.RoundToCash].
+//
+// [CLDR]: https://cldr.unicode.org/
+func (c Currency) CashUnit() decimal.Decimal {
+	if u, ok := cashLookup[c]; ok {
+		return u
+	}
+	return decimal.MustNew(1, c.Scale())
+}
+
 // Num returns the [3-digit code] assigned to the currency by the ISO 4217 standard.
 // If the currency does not have such a [code], the method will return an empty string.
+// For currencies defined by [RegisterCurr], the method returns the numeric code
+// given at registration.
 //
 // [3-digit code]: https://en.wikipedia.org/wiki/ISO_4217#Numeric_codes
 // [code]: https://en.wikipedia.org/wiki/ISO_4217#X_currencies_(funds,_precious_metals,_supranationals,_other)
@@ -360,13 +638,114 @@ func (c Currency) Num() string {
 // Code returns the [3-letter code] assigned to the currency by the ISO 4217 standard.
 // This code is a unique identifier of the currency and is used in
 // international finance and commerce.
-// This method always returns a valid code.
+// For currencies defined by [RegisterCurr], the method returns the code
+// given at registration.
+// For indices that do not correspond to any defined currency, for example
+// Currency(255) when fewer currencies are defined, the method returns an empty
+// string.
 //
 // [3-letter code]: https://en.wikipedia.org/wiki/ISO_4217#National_currencies
 func (c Currency) Code() string {
 	return codeLookup[c]
 }
 
+// Symbol returns the currency symbol defined by the [CLDR] for the English
+// locale, for example "$" for the US Dollar or "CA$" for the Canadian Dollar.
+// If the currency does not have a symbol, the method returns its 3-letter code.
+// Symbols and their position relative to the number vary by locale,
+// use [Formatter] to format amounts according to the conventions of a locale.
+// See also method [Currency.NarrowSymbol].
+//
+// [CLDR]: https://cldr.unicode.org
+func (c Currency) Symbol() string {
+	if s := symbolLookup[c]; s != "" {
+		return s
+	}
+	return c.Code()
+}
+
+// NarrowSymbol returns the narrow currency symbol defined by the [CLDR] for
+// the English locale, for example "$" for both the US Dollar and the Canadian
+// Dollar.
+// Narrow symbols are ambiguous and should only be used where the currency is
+// clear from the context.
+// If the currency does not have a narrow symbol, the method returns
+// the same value as [Currency.Symbol].
+//
+// [CLDR]: https://cldr.unicode.org
+func (c Currency) NarrowSymbol() string {
+	if s := narrowLookup[c]; s != "" {
+		return s
+	}
+	return c.Symbol()
+}
+
+// DisplayName returns the name of the currency in the language of the locale
+// identified by the tag, as defined by the [CLDR], for example "US-Dollar" for
+// the US Dollar in German or "dollar des États-Unis" in French.
+// If there is no name for the language and region of the tag, the name for
+// the language is used.
+// Names for a language are only used if the tag is written in the default
+// script of that language, so "zh-TW" and "zh-Hant" do not get the Simplified
+// Chinese names of "zh".
+// If there is no name for the language either, the method returns the English
+// name assigned by the ISO 4217 standard, and for currencies defined by
+// [RegisterCurr], their 3-letter code.
+//
+// [CLDR]: https://cldr.unicode.org
+func (c Currency) DisplayName(tag language.Tag) string {
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+	region, conf := tag.Region()
+	exact := conf == language.Exact
+	lang := base.String()
+	if exact {
+		if s, ok := displayNameLookup[lang+"-"+script.String()+"-"+region.String()][c]; ok {
+			return s
+		}
+	}
+	if s, ok := displayNameLookup[lang+"-"+script.String()][c]; ok {
+		return s
+	}
+	if def, _ := language.Make(lang).Script(); script == def {
+		if exact {
+			if s, ok := displayNameLookup[lang+"-"+region.String()][c]; ok {
+				return s
+			}
+		}
+		if s, ok := displayNameLookup[lang][c]; ok {
+			return s
+		}
+	}
+	if s := nameLookup[c]; s != "" {
+		return s
+	}
+	return c.Code()
+}
+
+// Countries returns the [ISO 3166] codes of the countries where the currency
+// is in use, as defined by the [CLDR], for example ["CH", "LI"] for
+// the Swiss Franc.
+// Funds, such as the WIR Euro, are associated with the countries of their
+// underlying currencies.
+// If the currency is not used by any country, such as gold or the Special
+// Drawing Rights, the method returns nil.
+// Modifying the returned slice does not affect the currency.
+//
+// [ISO 3166]: https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+// [CLDR]: https://cldr.unicode.org
+func (c Currency) Countries() []string {
+	return slices.Clone(countryLookup[c])
+}
+
+// IsFund returns true if the currency is designated as a fund by
+// the ISO 4217 standard, such as [USN] or [CLF].
+// Funds are units of account or settlement instruments rather than
+// circulating currencies.
+func (c Currency) IsFund() bool {
+	return fundLookup[c]
+}
+
 // NullCurrency represents a currency that can be null.
 // Its zero value is null.
 // NullCurrency is not thread-safe.
@@ -385,8 +764,12 @@ func (n *NullCurrency) Scan(value any) error {
 		n.Valid = false
 		return nil
 	}
+	if err := n.Currency.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
 	n.Valid = true
-	return n.Currency.Scan(value)
+	return nil
 }
 
 // Value implements the [driver.Valuer] interface.
@@ -410,8 +793,12 @@ func (n *NullCurrency) UnmarshalJSON(text []byte) error {
 		n.Valid = false
 		return nil
 	}
+	if err := n.Currency.UnmarshalJSON(text); err != nil {
+		n.Valid = false
+		return err
+	}
 	n.Valid = true
-	return n.Currency.UnmarshalJSON(text)
+	return nil
 }
 
 // MarshalJSON implements the [json.Marshaler] interface.
@@ -435,8 +822,12 @@ func (n *NullCurrency) UnmarshalBSONValue(typ byte, data []byte) error {
 		n.Valid = false
 		return nil
 	}
+	if err := n.Currency.UnmarshalBSONValue(typ, data); err != nil {
+		n.Valid = false
+		return err
+	}
 	n.Valid = true
-	return n.Currency.UnmarshalBSONValue(typ, data)
+	return nil
 }
 
 // MarshalBSONValue implements the [v2/bson.ValueMarshaler] interface.
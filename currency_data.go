@@ -3,6 +3,8 @@
 
 package money
 
+import "math"
+
 const (
 	XXX Currency = 0   // The codes assigned for transactions where no currency is involved
 	XTS Currency = 1   // Codes specifically reserved for testing purposes
@@ -185,6 +187,188 @@ const (
 	ZWG Currency = 178 // Zimbabwe Gold
 )
 
+var currList = [...]Currency{
+	XXX,
+	XTS,
+	AED,
+	AFN,
+	ALL,
+	AMD,
+	AOA,
+	ARS,
+	AUD,
+	AWG,
+	AZN,
+	BAM,
+	BBD,
+	BDT,
+	BGN,
+	BHD,
+	BIF,
+	BMD,
+	BND,
+	BOB,
+	BOV,
+	BRL,
+	BSD,
+	BTN,
+	BWP,
+	BYN,
+	BZD,
+	CAD,
+	CDF,
+	CHE,
+	CHF,
+	CHW,
+	CLF,
+	CLP,
+	CNY,
+	COP,
+	COU,
+	CRC,
+	CUP,
+	CVE,
+	CZK,
+	DJF,
+	DKK,
+	DOP,
+	DZD,
+	EGP,
+	ERN,
+	ETB,
+	EUR,
+	FJD,
+	FKP,
+	GBP,
+	GEL,
+	GHS,
+	GIP,
+	GMD,
+	GNF,
+	GTQ,
+	GYD,
+	HKD,
+	HNL,
+	HTG,
+	HUF,
+	IDR,
+	ILS,
+	INR,
+	IQD,
+	IRR,
+	ISK,
+	JMD,
+	JOD,
+	JPY,
+	KES,
+	KGS,
+	KHR,
+	KMF,
+	KPW,
+	KRW,
+	KWD,
+	KYD,
+	KZT,
+	LAK,
+	LBP,
+	LKR,
+	LRD,
+	LSL,
+	LYD,
+	MAD,
+	MDL,
+	MGA,
+	MKD,
+	MMK,
+	MNT,
+	MOP,
+	MRU,
+	MUR,
+	MVR,
+	MWK,
+	MXN,
+	MXV,
+	MYR,
+	MZN,
+	NAD,
+	NGN,
+	NIO,
+	NOK,
+	NPR,
+	NZD,
+	OMR,
+	PAB,
+	PEN,
+	PGK,
+	PHP,
+	PKR,
+	PLN,
+	PYG,
+	QAR,
+	RON,
+	RSD,
+	RUB,
+	RWF,
+	SAR,
+	SBD,
+	SCR,
+	SDG,
+	SEK,
+	SGD,
+	SHP,
+	SLE,
+	SOS,
+	SRD,
+	SSP,
+	STN,
+	SVC,
+	SYP,
+	SZL,
+	THB,
+	TJS,
+	TMT,
+	TND,
+	TOP,
+	TRY,
+	TTD,
+	TWD,
+	TZS,
+	UAH,
+	UGX,
+	USD,
+	USN,
+	UYI,
+	UYU,
+	UYW,
+	UZS,
+	VED,
+	VES,
+	VND,
+	VUV,
+	WST,
+	XAD,
+	XAF,
+	XAG,
+	XAU,
+	XBA,
+	XBB,
+	XBC,
+	XBD,
+	XCD,
+	XCG,
+	XDR,
+	XOF,
+	XPD,
+	XPF,
+	XPT,
+	XSU,
+	XUA,
+	YER,
+	ZAR,
+	ZMW,
+	ZWG,
+}
+
 var currLookup = map[string]Currency{
 	"XXX": XXX, "xxx": XXX, "999": XXX, // The codes assigned for transactions where no currency is involved
 	"XTS": XTS, "xts": XTS, "963": XTS, // Codes specifically reserved for testing purposes
@@ -367,7 +551,7 @@ var currLookup = map[string]Currency{
 	"ZWG": ZWG, "zwg": ZWG, "924": ZWG, // Zimbabwe Gold
 }
 
-var scaleLookup = [...]int8{
+var scaleLookup = [math.MaxUint8 + 1]int8{
 	XXX: 0, // The codes assigned for transactions where no currency is involved
 	XTS: 0, // Codes specifically reserved for testing purposes
 	AED: 2, // UAE Dirham
@@ -549,7 +733,7 @@ var scaleLookup = [...]int8{
 	ZWG: 2, // Zimbabwe Gold
 }
 
-var numLookup = [...]string{
+var numLookup = [math.MaxUint8 + 1]string{
 	XXX: "999", // The codes assigned for transactions where no currency is involved
 	XTS: "963", // Codes specifically reserved for testing purposes
 	AED: "784", // UAE Dirham
@@ -731,7 +915,7 @@ var numLookup = [...]string{
 	ZWG: "924", // Zimbabwe Gold
 }
 
-var codeLookup = [...]string{
+var codeLookup = [math.MaxUint8 + 1]string{
 	XXX: "XXX", // The codes assigned for transactions where no currency is involved
 	XTS: "XTS", // Codes specifically reserved for testing purposes
 	AED: "AED", // UAE Dirham
@@ -912,3 +1096,197 @@ var codeLookup = [...]string{
 	ZMW: "ZMW", // Zambian Kwacha
 	ZWG: "ZWG", // Zimbabwe Gold
 }
+
+var fundLookup = [math.MaxUint8 + 1]bool{
+	BOV: true, // Mvdol
+	CHE: true, // WIR Euro
+	CHW: true, // WIR Franc
+	CLF: true, // Unidad de Fomento
+	COU: true, // Unidad de Valor Real
+	MXV: true, // Mexican Unidad de Inversion (UDI)
+	USN: true, // US Dollar (Next day)
+	UYI: true, // Uruguay Peso en Unidades Indexadas (UI)
+	UYW: true, // Unidad Previsional
+}
+
+var nameLookup = [math.MaxUint8 + 1]string{
+	XXX: "The codes assigned for transactions where no currency is involved",
+	XTS: "Codes specifically reserved for testing purposes",
+	AED: "UAE Dirham",
+	AFN: "Afghani",
+	ALL: "Lek",
+	AMD: "Armenian Dram",
+	AOA: "Kwanza",
+	ARS: "Argentine Peso",
+	AUD: "Australian Dollar",
+	AWG: "Aruban Florin",
+	AZN: "Azerbaijan Manat",
+	BAM: "Convertible Mark",
+	BBD: "Barbados Dollar",
+	BDT: "Taka",
+	BGN: "Bulgarian Lev",
+	BHD: "Bahraini Dinar",
+	BIF: "Burundi Franc",
+	BMD: "Bermudian Dollar",
+	BND: "Brunei Dollar",
+	BOB: "Boliviano",
+	BOV: "Mvdol",
+	BRL: "Brazilian Real",
+	BSD: "Bahamian Dollar",
+	BTN: "Ngultrum",
+	BWP: "Pula",
+	BYN: "Belarusian Ruble",
+	BZD: "Belize Dollar",
+	CAD: "Canadian Dollar",
+	CDF: "Congolese Franc",
+	CHE: "WIR Euro",
+	CHF: "Swiss Franc",
+	CHW: "WIR Franc",
+	CLF: "Unidad de Fomento",
+	CLP: "Chilean Peso",
+	CNY: "Yuan Renminbi",
+	COP: "Colombian Peso",
+	COU: "Unidad de Valor Real",
+	CRC: "Costa Rican Colon",
+	CUP: "Cuban Peso",
+	CVE: "Cabo Verde Escudo",
+	CZK: "Czech Koruna",
+	DJF: "Djibouti Franc",
+	DKK: "Danish Krone",
+	DOP: "Dominican Peso",
+	DZD: "Algerian Dinar",
+	EGP: "Egyptian Pound",
+	ERN: "Nakfa",
+	ETB: "Ethiopian Birr",
+	EUR: "Euro",
+	FJD: "Fiji Dollar",
+	FKP: "Falkland Islands Pound",
+	GBP: "Pound Sterling",
+	GEL: "Lari",
+	GHS: "Ghana Cedi",
+	GIP: "Gibraltar Pound",
+	GMD: "Dalasi",
+	GNF: "Guinean Franc",
+	GTQ: "Quetzal",
+	GYD: "Guyana Dollar",
+	HKD: "Hong Kong Dollar",
+	HNL: "Lempira",
+	HTG: "Gourde",
+	HUF: "Forint",
+	IDR: "Rupiah",
+	ILS: "New Israeli Sheqel",
+	INR: "Indian Rupee",
+	IQD: "Iraqi Dinar",
+	IRR: "Iranian Rial",
+	ISK: "Iceland Krona",
+	JMD: "Jamaican Dollar",
+	JOD: "Jordanian Dinar",
+	JPY: "Yen",
+	KES: "Kenyan Shilling",
+	KGS: "Som",
+	KHR: "Riel",
+	KMF: "Comorian Franc ",
+	KPW: "North Korean Won",
+	KRW: "Won",
+	KWD: "Kuwaiti Dinar",
+	KYD: "Cayman Islands Dollar",
+	KZT: "Tenge",
+	LAK: "Lao Kip",
+	LBP: "Lebanese Pound",
+	LKR: "Sri Lanka Rupee",
+	LRD: "Liberian Dollar",
+	LSL: "Loti",
+	LYD: "Libyan Dinar",
+	MAD: "Moroccan Dirham",
+	MDL: "Moldovan Leu",
+	MGA: "Malagasy Ariary",
+	MKD: "Denar",
+	MMK: "Kyat",
+	MNT: "Tugrik",
+	MOP: "Pataca",
+	MRU: "Ouguiya",
+	MUR: "Mauritius Rupee",
+	MVR: "Rufiyaa",
+	MWK: "Malawi Kwacha",
+	MXN: "Mexican Peso",
+	MXV: "Mexican Unidad de Inversion (UDI)",
+	MYR: "Malaysian Ringgit",
+	MZN: "Mozambique Metical",
+	NAD: "Namibia Dollar",
+	NGN: "Naira",
+	NIO: "Cordoba Oro",
+	NOK: "Norwegian Krone",
+	NPR: "Nepalese Rupee",
+	NZD: "New Zealand Dollar",
+	OMR: "Rial Omani",
+	PAB: "Balboa",
+	PEN: "Sol",
+	PGK: "Kina",
+	PHP: "Philippine Peso",
+	PKR: "Pakistan Rupee",
+	PLN: "Zloty",
+	PYG: "Guarani",
+	QAR: "Qatari Rial",
+	RON: "Romanian Leu",
+	RSD: "Serbian Dinar",
+	RUB: "Russian Ruble",
+	RWF: "Rwanda Franc",
+	SAR: "Saudi Riyal",
+	SBD: "Solomon Islands Dollar",
+	SCR: "Seychelles Rupee",
+	SDG: "Sudanese Pound",
+	SEK: "Swedish Krona",
+	SGD: "Singapore Dollar",
+	SHP: "Saint Helena Pound",
+	SLE: "Leone",
+	SOS: "Somali Shilling",
+	SRD: "Surinam Dollar",
+	SSP: "South Sudanese Pound",
+	STN: "Dobra",
+	SVC: "El Salvador Colon",
+	SYP: "Syrian Pound",
+	SZL: "Lilangeni",
+	THB: "Baht",
+	TJS: "Somoni",
+	TMT: "Turkmenistan New Manat",
+	TND: "Tunisian Dinar",
+	TOP: "Pa’anga",
+	TRY: "Turkish Lira",
+	TTD: "Trinidad and Tobago Dollar",
+	TWD: "New Taiwan Dollar",
+	TZS: "Tanzanian Shilling",
+	UAH: "Hryvnia",
+	UGX: "Uganda Shilling",
+	USD: "US Dollar",
+	USN: "US Dollar (Next day)",
+	UYI: "Uruguay Peso en Unidades Indexadas (UI)",
+	UYU: "Peso Uruguayo",
+	UYW: "Unidad Previsional",
+	UZS: "Uzbekistan Sum",
+	VED: "Bolívar Soberano",
+	VES: "Bolívar Soberano",
+	VND: "Dong",
+	VUV: "Vatu",
+	WST: "Tala",
+	XAD: "Arab Accounting Dinar",
+	XAF: "CFA Franc BEAC",
+	XAG: "Silver",
+	XAU: "Gold",
+	XBA: "Bond Markets Unit European Composite Unit (EURCO)",
+	XBB: "Bond Markets Unit European Monetary Unit (E.M.U.-6)",
+	XBC: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)",
+	XBD: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)",
+	XCD: "East Caribbean Dollar",
+	XCG: "Caribbean Guilder",
+	XDR: "SDR (Special Drawing Right)",
+	XOF: "CFA Franc BCEAO",
+	XPD: "Palladium",
+	XPF: "CFP Franc",
+	XPT: "Platinum",
+	XSU: "Sucre",
+	XUA: "ADB Unit of Account",
+	YER: "Yemeni Rial",
+	ZAR: "Rand",
+	ZMW: "Zambian Kwacha",
+	ZWG: "Zimbabwe Gold",
+}
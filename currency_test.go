@@ -4,8 +4,15 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
 )
 
 func TestCurrency_Interfaces(t *testing.T) {
@@ -113,6 +120,89 @@ func TestMustParseCurr(t *testing.T) {
 	})
 }
 
+func TestCurrByNum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			num  int
+			want Currency
+		}{
+			{840, USD},
+			{978, EUR},
+			{8, ALL},
+			{36, AUD},
+			{999, XXX},
+			{997, USN},
+		}
+		for _, tt := range tests {
+			got, err := CurrByNum(tt.num)
+			if err != nil {
+				t.Errorf("CurrByNum(%v) failed: %v", tt.num, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("CurrByNum(%v) = %v, want %v", tt.num, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []int{-840, 0, 1, 1000, 8400}
+		for _, num := range tests {
+			_, err := CurrByNum(num)
+			if err == nil {
+				t.Errorf("CurrByNum(%v) did not fail", num)
+			}
+		}
+	})
+
+	// Every numeric code must map back to its currency
+	for i, num := range numLookup {
+		if num == "" {
+			continue
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			t.Fatalf("strconv.Atoi(%q) failed: %v", num, err)
+		}
+		if got, err := CurrByNum(n); err != nil || got != Currency(i) {
+			t.Errorf("CurrByNum(%v) = %v, %v, want %v", n, got, err, Currency(i))
+		}
+	}
+}
+
+func TestCurrsForCountry(t *testing.T) {
+	tests := []struct {
+		country string
+		want    []Currency
+	}{
+		{"", nil},
+		{"XX", nil},
+		{"USA", nil},
+		{"US", []Currency{USD, USN}},
+		{"us", []Currency{USD, USN}},
+		{"CH", []Currency{CHF, CHE, CHW}},
+		{"LI", []Currency{CHF}},
+		{"DE", []Currency{EUR}},
+		{"BO", []Currency{BOB, BOV}},
+		{"UY", []Currency{UYU, UYI, UYW}},
+	}
+	for _, tt := range tests {
+		got := CurrsForCountry(tt.country)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("CurrsForCountry(%q) = %v, want %v", tt.country, got, tt.want)
+		}
+	}
+
+	// Every country of a currency must map back to the currency
+	for c, regions := range countryLookup {
+		for _, r := range regions {
+			if !slices.Contains(CurrsForCountry(r), c) {
+				t.Errorf("CurrsForCountry(%q) = %v, does not contain %v", r, CurrsForCountry(r), c)
+			}
+		}
+	}
+}
+
 func TestCurrency_Scale(t *testing.T) {
 	tests := []struct {
 		curr Currency
@@ -280,6 +370,164 @@ func TestCurrency_Scale(t *testing.T) {
 	}
 }
 
+func TestCurrency_CashUnit(t *testing.T) {
+	tests := []struct {
+		curr Currency
+		want string
+	}{
+		{XXX, "1"},
+		{JPY, "1"},
+		{USD, "0.01"},
+		{OMR, "0.001"},
+		{CHF, "0.05"},
+		{CAD, "0.05"},
+		{DKK, "0.50"},
+		{SEK, "1"},
+	}
+	for _, tt := range tests {
+		got := tt.curr.CashUnit()
+		want := decimal.MustParse(tt.want)
+		if got != want {
+			t.Errorf("%v.CashUnit() = %v, want %v", tt.curr, got, want)
+		}
+	}
+	for c, u := range cashLookup {
+		if u.Scale() > c.Scale() {
+			t.Errorf("%v.CashUnit() = %v, has more digits than the currency", c, u)
+		}
+	}
+}
+
+func TestCurrency_Symbol(t *testing.T) {
+	tests := []struct {
+		curr         Currency
+		want, narrow string
+	}{
+		{XXX, "XXX", "XXX"},
+		{USD, "$", "$"},
+		{CAD, "CA$", "$"},
+		{EUR, "€", "€"},
+		{JPY, "¥", "¥"},
+		{CHF, "CHF", "CHF"},
+		{ARS, "ARS", "$"},
+		{PLN, "PLN", "zł"},
+		{XOF, "F\u202fCFA", "F\u202fCFA"},
+	}
+	for _, tt := range tests {
+		got := tt.curr.Symbol()
+		if got != tt.want {
+			t.Errorf("%v.Symbol() = %q, want %q", tt.curr, got, tt.want)
+		}
+		got = tt.curr.NarrowSymbol()
+		if got != tt.narrow {
+			t.Errorf("%v.NarrowSymbol() = %q, want %q", tt.curr, got, tt.narrow)
+		}
+	}
+}
+
+func TestCurrency_DisplayName(t *testing.T) {
+	tests := []struct {
+		curr Currency
+		tag  string
+		want string
+	}{
+		// Languages
+		{USD, "de", "US-Dollar"},
+		{USD, "fr", "dollar des États-Unis"},
+		{JPY, "ja", "日本円"},
+		{EUR, "zh", "欧元"},
+
+		// Regions fall back to their languages
+		{USD, "de-AT", "US-Dollar"},
+		{USD, "fr-CA", "dollar américain"},
+		{EUR, "fr-CA", "euro"},
+		{USD, "fr-CH", "dollar des États-Unis"},
+		{CHF, "zh-Hant-TW", "Swiss Franc"},
+		{EUR, "zh-CN", "欧元"},
+		{EUR, "zh-Hans", "欧元"},
+
+		// Other scripts do not fall back to their languages
+		{EUR, "zh-TW", "Euro"},
+		{EUR, "zh-Hant", "Euro"},
+		{EUR, "zh-Hant-HK", "Euro"},
+
+		// Languages fall back to the ISO 4217 names
+		{EUR, "de", "Euro"},
+		{USD, "en", "US Dollar"},
+		{USD, "en-GB", "US Dollar"},
+		{USD, "tlh", "US Dollar"},
+		{GBP, "und", "Pound Sterling"},
+		{XTS, "fr", "Codes specifically reserved for testing purposes"},
+	}
+	for _, tt := range tests {
+		tag := language.MustParse(tt.tag)
+		if got := tt.curr.DisplayName(tag); got != tt.want {
+			t.Errorf("%v.DisplayName(%v) = %q, want %q", tt.curr, tag, got, tt.want)
+		}
+	}
+}
+
+func TestCurrency_Countries(t *testing.T) {
+	tests := []struct {
+		curr Currency
+		want []string
+	}{
+		{XXX, nil},
+		{XAU, nil},
+		{USD, []string{"AS", "BQ", "EC", "FM", "GU", "IO", "MH", "MP", "PA", "PR", "PW", "SV", "TC", "TL", "UM", "US", "VG", "VI"}},
+		{CHF, []string{"CH", "LI"}},
+		{CHE, []string{"CH"}},
+		{JPY, []string{"JP"}},
+	}
+	for _, tt := range tests {
+		got := tt.curr.Countries()
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%v.Countries() = %v, want %v", tt.curr, got, tt.want)
+		}
+	}
+
+	// Modifying the result must not affect the currency
+	got := CHF.Countries()
+	got[0] = "XX"
+	if want := []string{"CH", "LI"}; !slices.Equal(CHF.Countries(), want) {
+		t.Errorf("CHF.Countries() = %v, want %v", CHF.Countries(), want)
+	}
+
+	// Countries must be sorted officially assigned ISO 3166 codes
+	for c, regions := range countryLookup {
+		if !slices.IsSorted(regions) {
+			t.Errorf("%v.Countries() = %v, not sorted", c, regions)
+		}
+		for _, r := range regions {
+			if len(r) != 2 || strings.IndexFunc(r, isNotUpper) >= 0 {
+				t.Errorf("%v.Countries() = %v, contains invalid code %q", c, regions, r)
+			}
+		}
+	}
+}
+
+func TestCurrency_IsFund(t *testing.T) {
+	tests := []struct {
+		curr Currency
+		want bool
+	}{
+		{XXX, false},
+		{USD, false},
+		{USN, true},
+		{CLF, true},
+		{CLP, false},
+		{CHE, true},
+		{CHW, true},
+		{XAU, false},
+	}
+	for _, tt := range tests {
+		got := tt.curr.IsFund()
+		if got != tt.want {
+			t.Errorf("%v.IsFund() = %t, want %t", tt.curr, got, tt.want)
+		}
+	}
+}
+
 func TestCurrency_Num(t *testing.T) {
 	tests := []struct {
 		curr Currency
@@ -702,6 +950,264 @@ func TestNullCurrency_Scan(t *testing.T) {
 			if err == nil {
 				t.Errorf("Scan(%q) did not fail", tt)
 			}
+			if got.Valid {
+				t.Errorf("Scan(%q) set Valid to true", tt)
+			}
+		}
+	})
+}
+
+func TestAllCurrs(t *testing.T) {
+	got := AllCurrs()
+	if len(got) < len(currList) {
+		t.Fatalf("len(AllCurrs()) = %v, want at least %v", len(got), len(currList))
+	}
+	for i, c := range got[:len(currList)] {
+		if c != Currency(i) { //nolint:gosec
+			t.Errorf("AllCurrs()[%v] = %v, want %v", i, c, Currency(i)) //nolint:gosec
+		}
+		if got, err := ParseCurr(c.Code()); err != nil || got != c {
+			t.Errorf("ParseCurr(%q) = %v, %v, want %v, nil", c.Code(), got, err, c)
+		}
+	}
+
+	// Registered currencies
+	n := len(got)
+	c := MustRegisterCurr("QAC", "", 4)
+	t.Cleanup(func() { unregister(c) })
+	got = AllCurrs()
+	if len(got) != n+1 || !slices.Contains(got[len(currList):], c) {
+		t.Errorf("AllCurrs() = %v, want %v among registered currencies", got[len(currList):], c)
+	}
+
+	// Modification
+	got[0] = USD
+	if got := AllCurrs(); got[0] != XXX {
+		t.Errorf("AllCurrs()[0] = %v, want %v", got[0], XXX)
+	}
+}
+
+// unregister removes a currency registered by a test.
+func unregister(c Currency) {
+	delete(currLookup, codeLookup[c])
+	delete(currLookup, strings.ToLower(codeLookup[c]))
+	delete(currLookup, numLookup[c])
+	codeLookup[c], numLookup[c], scaleLookup[c] = "", "", 0
+}
+
+func TestRegisterCurr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			code, num string
+			scale     int
+		}{
+			{"QBT", "", 8},
+			{"QPT", "", 0},
+			{"QET", "001", 18},
+		}
+		for _, tt := range tests {
+			got, err := RegisterCurr(tt.code, tt.num, tt.scale)
+			if err != nil {
+				t.Errorf("RegisterCurr(%q, %q, %v) failed: %v", tt.code, tt.num, tt.scale, err)
+				continue
+			}
+			t.Cleanup(func() { unregister(got) })
+			if got.Code() != tt.code || got.Num() != tt.num || got.Scale() != tt.scale {
+				t.Errorf("RegisterCurr(%q, %q, %v) = %v with (%q, %q, %v)", tt.code, tt.num, tt.scale, got, got.Code(), got.Num(), got.Scale())
+			}
+			// Parsing
+			for _, s := range []string{tt.code, strings.ToLower(tt.code), tt.num} {
+				if s == "" {
+					continue
+				}
+				c, err := ParseCurr(s)
+				if err != nil {
+					t.Errorf("ParseCurr(%q) failed: %v", s, err)
+					continue
+				}
+				if c != got {
+					t.Errorf("ParseCurr(%q) = %v, want %v", s, c, got)
+				}
+			}
+			// Amounts
+			a, err := ParseAmount(tt.code, "1")
+			if err != nil {
+				t.Errorf("ParseAmount(%q, %q) failed: %v", tt.code, "1", err)
+				continue
+			}
+			if a.Curr() != got || a.Scale() != tt.scale {
+				t.Errorf("ParseAmount(%q, %q) = %q, want scale %v", tt.code, "1", a, tt.scale)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			code, num string
+			scale     int
+		}{
+			"code 1":  {"", "", 2},
+			"code 2":  {"QB", "", 2},
+			"code 3":  {"QBTC", "", 2},
+			"code 4":  {"qbt", "", 2},
+			"code 5":  {"Q1T", "", 2},
+			"code 6":  {"USD", "", 2},
+			"num 1":   {"QBT", "1", 2},
+			"num 2":   {"QBT", "abc", 2},
+			"num 3":   {"QBT", "840", 2},
+			"scale 1": {"QBT", "", -1},
+			"scale 2": {"QBT", "", 20},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := RegisterCurr(tt.code, tt.num, tt.scale)
+				if err == nil {
+					t.Errorf("RegisterCurr(%q, %q, %v) did not fail", tt.code, tt.num, tt.scale)
+				}
+			})
 		}
 	})
+
+	t.Run("limit", func(t *testing.T) {
+		var got []Currency
+		defer func() {
+			for _, c := range got {
+				unregister(c)
+			}
+		}()
+		for i := 0; ; i++ {
+			code := string([]byte{'Q', 'A' + byte(i/26), 'A' + byte(i%26)})
+			if _, err := ParseCurr(code); err == nil {
+				continue // QAR
+			}
+			c, err := RegisterCurr(code, "", 2)
+			if err != nil {
+				break
+			}
+			got = append(got, c)
+		}
+		if len(got) == 0 || got[len(got)-1] != Currency(255) {
+			t.Errorf("RegisterCurr registered %v currencies, want up to %v", len(got), Currency(255))
+		}
+	})
+}
+
+func TestSetCurrScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := MustRegisterCurr("QST", "", 2)
+		t.Cleanup(func() { unregister(c) })
+		for _, scale := range []int{3, 0, decimal.MaxScale, 2} {
+			if err := SetCurrScale(c, scale); err != nil {
+				t.Errorf("SetCurrScale(%v, %v) failed: %v", c, scale, err)
+				continue
+			}
+			if got := c.Scale(); got != scale {
+				t.Errorf("SetCurrScale(%v, %v) set scale %v, want %v", c, scale, got, scale)
+			}
+		}
+		// Amounts follow the new scale
+		if err := SetCurrScale(c, 3); err != nil {
+			t.Fatalf("SetCurrScale(%v, %v) failed: %v", c, 3, err)
+		}
+		a := MustParseAmount("QST", "3.4599")
+		if got, want := a.RoundToCurr().String(), "QST 3.460"; got != want {
+			t.Errorf("%q.RoundToCurr() = %q, want %q", a, got, want)
+		}
+		if got, want := MustParseAmount("QST", "1").String(), "QST 1.000"; got != want {
+			t.Errorf("MustParseAmount(%q, %q) = %q, want %q", "QST", "1", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			c     Currency
+			scale int
+		}{
+			"undefined":      {Currency(255), 2},
+			"negative scale": {USD, -1},
+			"large scale":    {USD, decimal.MaxScale + 1},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				if err := SetCurrScale(tt.c, tt.scale); err == nil {
+					t.Errorf("SetCurrScale(%v, %v) did not fail", tt.c, tt.scale)
+				}
+				if tt.c == USD && USD.Scale() != 2 {
+					t.Errorf("SetCurrScale(%v, %v) changed scale to %v", tt.c, tt.scale, USD.Scale())
+				}
+			})
+		}
+
+		// Undefined currency
+		var target *UnknownCurrencyError
+		if err := SetCurrScale(Currency(255), 2); !errors.As(err, &target) {
+			t.Errorf("SetCurrScale(%v, %v) = %v, want %T", 255, 2, err, target)
+		}
+	})
+}
+
+func TestRegisterHistoricalCurr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			code, wantNum string
+			wantScale     int
+		}{
+			{"DEM", "276", 2},
+			{"ITL", "380", 0},
+			{"ZWL", "932", 2},
+		}
+		for _, tt := range tests {
+			got, err := RegisterHistoricalCurr(tt.code)
+			if err != nil {
+				t.Errorf("RegisterHistoricalCurr(%q) failed: %v", tt.code, err)
+				continue
+			}
+			t.Cleanup(func() { unregister(got) })
+			if got.Code() != tt.code || got.Num() != tt.wantNum || got.Scale() != tt.wantScale {
+				t.Errorf("RegisterHistoricalCurr(%q) = %v with (%q, %q, %v), want (%q, %q, %v)", tt.code, got, got.Code(), got.Num(), got.Scale(), tt.code, tt.wantNum, tt.wantScale)
+			}
+			// Repeated registration
+			again, err := RegisterHistoricalCurr(tt.code)
+			if err != nil {
+				t.Errorf("RegisterHistoricalCurr(%q) failed: %v", tt.code, err)
+				continue
+			}
+			if again != got {
+				t.Errorf("RegisterHistoricalCurr(%q) = %v, want %v", tt.code, again, got)
+			}
+		}
+	})
+
+	t.Run("reassigned num", func(t *testing.T) {
+		histLookup["QHT"] = histCurr{num: "840", scale: 2}
+		defer delete(histLookup, "QHT")
+		got, err := RegisterHistoricalCurr("QHT")
+		if err != nil {
+			t.Fatalf("RegisterHistoricalCurr(%q) failed: %v", "QHT", err)
+		}
+		defer unregister(got)
+		if got.Num() != "" {
+			t.Errorf("RegisterHistoricalCurr(%q).Num() = %q, want %q", "QHT", got.Num(), "")
+		}
+		if c := MustParseCurr("840"); c != USD {
+			t.Errorf("ParseCurr(%q) = %v, want %v", "840", c, USD)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "dem", "USD", "EUR", "UUU"}
+		for _, tt := range tests {
+			_, err := RegisterHistoricalCurr(tt)
+			if err == nil {
+				t.Errorf("RegisterHistoricalCurr(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func BenchmarkCurrency_Symbol(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stringSink = CAD.Symbol()
+	}
 }
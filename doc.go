@@ -20,6 +20,16 @@ The currently supported currencies use scales of 0, 2, or 3:
     For instance, the minor unit of the [Omani Rial], 1 baisa, is represented
     as 0.001 rials.
 
+Currencies that are not defined by ISO 4217, such as cryptocurrencies or
+loyalty points, can be defined during program initialization using
+[RegisterCurr].
+Currencies withdrawn from circulation, such as the Deutsche Mark, can be
+defined the same way using [RegisterHistoricalCurr].
+The scale of any currency can be overridden for the whole program using
+[SetCurrScale], for example to account for fuel prices in tenths of a cent.
+Every ISO 4217 currency has a typed constant, such as [USD] or [EUR], so
+misspelled codes fail to compile, and [AllCurrs] lists all defined currencies.
+
 [Amount] is a struct with two fields:
 
   - Currency: a [Currency] in which the amount is denominated.
@@ -32,18 +42,57 @@ The currently supported currencies use scales of 0, 2, or 3:
   - Rate: a positive [decimal.Decimal] representing how many units of the quote
     currency are needed to exchange for 1 unit of the base currency.
 
+[Range] is a closed interval of amounts denominated in the same currency,
+such as a pricing tier or a withdrawal limit.
+
+[Entry] is a non-negative amount posted to the [Debit] or [Credit] side of
+an account in double-entry bookkeeping, and [CheckBalanced] verifies that
+the debits of a transaction are equal to its credits in every currency.
+
+[Basket] holds balances in several currencies at once, at most one amount
+per currency, ordered by currency code.
+
+[Summer] accumulates the sum, mean, minimum and maximum of amounts one at
+a time, and functions [Sum], [Mean], [Min], and [Max] aggregate sequences
+of amounts.
+Functions [Total], [MinOf], [MaxOf], and [ClampSlice] work on slices and
+check currencies once for the whole slice.
+[RunningBalance] is a running total that can be updated concurrently by
+multiple goroutines.
+Function [Compare] can be passed to [slices.SortFunc], and functions
+[SortAscending] and [SortDescending] sort amounts in a single currency.
+[Amount.Key] returns a comparable value for using amounts as map keys, and
+[Amount.Hash] and [Amount.AppendCanonical] return a hash and an encoding
+that are equal for equal amounts, such as "USD 1.0" and "USD 1.00".
+Amounts implement [quick.Generator], so they can be used as arguments of
+functions checked by [quick.Check].
+
 # Constraints
 
 The range of an amount is determined by the scale of its currency.
 Similarly, the range of an exchange rate is determined by the scale of its quote
 currency.
-Here are the ranges for scales 0, 2, and 3:
-
-	| Example      | Scale | Minimum                              | Maximum                             |
-	| ------------ | ----- | ------------------------------------ | ----------------------------------- |
-	| Japanese Yen | 0     | -9,999,999,999,999,999,999           | 9,999,999,999,999,999,999           |
-	| US Dollar    | 2     |    -99,999,999,999,999,999.99        |    99,999,999,999,999,999.99        |
-	| Omani Rial   | 3     |     -9,999,999,999,999,999.999       |     9,999,999,999,999,999.999       |
+Amounts have 19 digits in total, so they have 19 - scale digits
+for the integer part.
+Here are the ranges for scales 0, 2, 3, 8, and 18, where the last two are
+typical for cryptocurrencies defined with [RegisterCurr]:
+
+	| Example      | Scale | Minimum                                       | Maximum                                      |
+	| ------------ | ----- | --------------------------------------------- | -------------------------------------------- |
+	| Japanese Yen | 0     | -9,999,999,999,999,999,999                    | 9,999,999,999,999,999,999                    |
+	| US Dollar    | 2     |    -99,999,999,999,999,999.99                 |    99,999,999,999,999,999.99                 |
+	| Omani Rial   | 3     |     -9,999,999,999,999,999.999                |     9,999,999,999,999,999.999                |
+	| Bitcoin      | 8     |            -99,999,999,999.99999999           |            99,999,999,999.99999999           |
+	| Ether        | 18    |                         -9.999999999999999999 |                         9.999999999999999999 |
+
+Amounts are not stored as int64 minor units.
+The underlying [decimal.Decimal] type keeps up to 19 significant digits,
+which are shared between the integer and fractional parts.
+Operations whose results fall outside the range above, for example sums of
+very large amounts, return an overflow error instead of wrapping around.
+Amounts with more digits after the decimal point than the scale of their
+currency, such as intermediate results of [Amount.Quo], reduce the number of
+digits available for the integer part accordingly.
 
 Subnormal numbers are not supported by the underlying [decimal.Decimal] type.
 Consequently, amounts and exchange rates between -0.00000000000000000005 and
@@ -57,20 +106,62 @@ The package provides methods for converting:
     [ParseAmount], [Amount.String], [Amount.Format],
     [ParseExchRate], [ExchangeRate.String], [ExchangeRate.Format].
   - from/to float64:
-    [NewAmountFromFloat64], [Amount.Float64],
+    [NewAmountFromFloat64], [NewAmountFromFloat64Round], [Amount.Float64],
     [NewExchRateFromFloat64], [ExchangeRate.Float64].
   - from/to int64:
     [NewAmount], [NewAmountFromInt64], [Amount.Int64],
-    [NewAmountFromMinorUnits], [Amount.MinorUnits],
+    [NewAmountFromMinorUnits], [Amount.MinorUnits], [Amount.MajorMinor],
     [NewExchRate], [NewExchRateFromInt64], [ExchangeRate.Int64].
   - from/to decimal:
     [NewAmountFromDecimal], [Amount.Decimal],
     [NewExchRateFromDecimal], [ExchangeRate.Decimal].
+  - to personal finance formats:
+    [Amount.OFXAmount], [Amount.QIFAmount].
+  - from/to payment message formats:
+    [ISO20022Amount], [ParseSWIFTAmount], [Amount.SWIFTAmount].
+  - from/to text, such as XML attributes:
+    [Amount.MarshalText], [Amount.UnmarshalText].
+  - from/to a fixed-width 13-byte binary format, such as [encoding/gob] or binary caches:
+    [Amount.MarshalBinary], [Amount.UnmarshalBinary].
+  - from/to JSON:
+    [Amount.MarshalJSON], [Amount.UnmarshalJSON], [TextAmount], [MinorUnits].
+  - from/to YAML configuration files:
+    [Amount.MarshalYAML], [Amount.UnmarshalYAML].
+  - from/to integer amounts of payment service providers:
+    [Amount.ProviderUnits], [NewAmountFromProviderUnits], [Provider], [Stripe].
+  - from/to protobuf:
+    [NewAmountFromProto], [Amount.Proto].
+  - from/to SQL columns:
+    [Amount.Scan], [Amount.Value], [NullAmount], [MinorUnits].
+  - from/to MongoDB documents:
+    [Amount.MarshalBSONValue], [Amount.UnmarshalBSONValue], [NullAmount].
+  - from/to localized strings:
+    [Formatter.Parse], [Formatter.Format], [Amount.FormatTrimWhole], [Amount.FormatTAccount].
+  - to strings with custom patterns, such as accounting parentheses:
+    [Formatter.WithPattern], [Formatter.WithScale].
+  - from/to accounting negatives, such as "(1,234.56)", "1,234.56-", or "1,234.56 CR":
+    [Formatter.WithNegativeStyle], [Formatter.Parse].
+
+Amounts received in requests can be checked with [Validate] before they are
+processed, and [ValidateValue] can be registered as a custom struct tag rule
+in validation libraries.
 
 See the documentation for each method for more details.
 
 # Operations
 
+Factors and divisors, such as tax rates, fees, or margins, are passed to
+operations as [decimal.Decimal] values, for example decimal.MustParse("0.0725"),
+so they do not need to be scaled to integers.
+[Amount.MulRound] and [Amount.QuoRound] round the result to the scale of
+the currency using an explicitly specified [RoundingMode].
+Chains of operations, such as a price multiplied by a quantity, discounted,
+and taxed, can be built with [Calc], which keeps full precision in
+intermediate results and rounds only the final one.
+A chain created by [NewAuditedCalc] also records every operation, so that
+[Calc.Audit] can explain how the result was obtained and [Replay] can verify
+a stored explanation.
+
 Each arithmetic operation is performed in two steps:
 
  1. The operation is initially performed using uint64 arithmetic.
@@ -91,7 +182,7 @@ The following rules determine the significance of digits during step 2:
 
   - [Amount.Add], [Amount.Sub], [Amount.SubAbs], [Amount.Mul], [Amount.AddMul],
     [Amount.AddQuo], [Amount.SubMul], [Amount.SubQuo],
-    [Amount.Quo], [Amount.QuoRem], [ExchangeRate.Conv], [ExchangeRate.Mul]:
+    [Amount.Quo], [Amount.QuoRem], [Amount.DivMod], [ExchangeRate.Conv], [ExchangeRate.Mul]:
     All digits in the integer part are significant.
     In the fractional part, digits are significant up to the scale of
     the currency.
@@ -100,6 +191,14 @@ The following rules determine the significance of digits during step 2:
     All digits in the integer part are significant, while digits in the
     fractional part are considered insignificant.
 
+Amounts do not have a negative zero.
+Parsing "-0.00", negating zero, or rounding a small negative amount, such as
+-0.004 US Dollars, to the scale of the currency always results in zero, so
+[Amount.Sign], [Amount.IsNeg], and the == operator treat such results
+like any other zero amount.
+Use [Amount.Neg], [Amount.Abs], and [Amount.CopySign] to change the sign of
+an amount instead of multiplying it by -1.
+
 # Rounding
 
 Implicit rounding applies when a result exceeds 19 digits.
@@ -123,21 +222,33 @@ explicit rounding:
     [Amount.Floor], [Amount.FloorToCurr], [ExchangeRate.Floor].
   - rounding towards zero:
     [Amount.Trunc], [Amount.TruncToCurr], [ExchangeRate.Trunc].
+  - rounding using a [RoundingMode] chosen by the caller or set
+    package-wide via [DefaultRoundingMode]:
+    [Amount.RoundWith], [Amount.MulRound], [Amount.QuoRound], [ExchangeRate.ConvRound].
+  - rounding to a multiple of a unit, such as the smallest coin in circulation:
+    [Amount.RoundToCash], [Amount.RoundToUnit].
 
 See the documentation for each method for more details.
 
 # Errors
 
 All methods are panic-free and pure.
+Only functions with the Must prefix, such as [MustParseAmount], panic, and
+they are intended for initialization of global variables and for tests.
+There is no need to check [Amount.SameCurr] before an operation, as
+the operation itself reports a mismatch.
 Errors are returned in the following cases:
 
   - Currency Mismatch.
-    All arithmetic operations except for [Amount.Rat] return an error if
+    All arithmetic operations except for [Amount.Rat] and [Amount.Ratio] return an error if
     the operands use different currencies.
+    The same applies to comparisons, such as [Amount.Cmp], [Amount.Min],
+    [Amount.Max], and [Amount.Clamp], which never fall back to comparing
+    numeric values of amounts in different currencies.
 
   - Division by Zero.
-    Unlike the standard library, [Amount.Quo], [Amount.QuoRem], [Amount.Rat], [Amount.AddQuo],
-    and [Amount.SubQuo] do not panic when dividing by 0.
+    Unlike the standard library, [Amount.Quo], [Amount.QuoRem], [Amount.DivMod], [Amount.Rat],
+    [Amount.Ratio], [Amount.AddQuo], and [Amount.SubQuo] do not panic when dividing by 0.
     Instead, they return an error.
 
   - Overflow.
@@ -153,10 +264,18 @@ Errors are returned in the following cases:
     as the result of these operations is an exchange rate, and exchange rates
     cannot be 0.
 
+Errors can be inspected with [errors.Is] and [errors.As] instead of matching
+their messages: currency mismatches match [ErrCurrencyMismatch], overflows
+match [ErrOverflow], and parsing functions return [UnknownCurrencyError] and
+[InvalidAmountError] for invalid currency codes and numbers.
+
 [Japanese Yen]: https://en.wikipedia.org/wiki/Japanese_yen
 [US Dollar]: https://en.wikipedia.org/wiki/United_States_dollar
 [Omani Rial]: https://en.wikipedia.org/wiki/Omani_rial
 [ISO 4217]: https://en.wikipedia.org/wiki/ISO_4217
 [big.Int]: https://pkg.go.dev/math/big#Int
+[slices.SortFunc]: https://pkg.go.dev/slices#SortFunc
+[quick.Generator]: https://pkg.go.dev/testing/quick#Generator
+[quick.Check]: https://pkg.go.dev/testing/quick#Check
 */
 package money
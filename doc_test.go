@@ -5,12 +5,19 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"testing/quick"
 
 	"github.com/govalues/decimal"
 	"github.com/lunafinancialgroup/money"
+	"golang.org/x/text/language"
 )
 
 func TaxAmount(price money.Amount, taxRate decimal.Decimal) (money.Amount, money.Amount, error) {
@@ -563,6 +570,37 @@ func ExampleMustParseCurr_codes() {
 	// USD
 }
 
+func ExampleCurrByNum() {
+	fmt.Println(money.CurrByNum(840))
+	fmt.Println(money.CurrByNum(8))
+	fmt.Println(money.CurrByNum(1))
+	// Output:
+	// USD <nil>
+	// ALL <nil>
+	// XXX unknown currency "001"
+}
+
+func ExampleCurrsForCountry() {
+	fmt.Println(money.CurrsForCountry("DE"))
+	fmt.Println(money.CurrsForCountry("CH"))
+	fmt.Println(money.CurrsForCountry("XX"))
+	// Output:
+	// [EUR]
+	// [CHF CHE CHW]
+	// []
+}
+
+func ExampleAllCurrs() {
+	var funds []money.Currency
+	for _, c := range money.AllCurrs() {
+		if c.IsFund() {
+			funds = append(funds, c)
+		}
+	}
+	fmt.Println(funds)
+	// Output: [BOV CHE CHW CLF COU MXV USN UYI UYW]
+}
+
 func ExampleCurrency_String() {
 	c := money.USD
 	fmt.Println(c.String())
@@ -595,6 +633,75 @@ func ExampleCurrency_Num() {
 	// 512
 }
 
+func ExampleCurrency_CashUnit() {
+	c := money.CHF
+	s := money.SEK
+	u := money.USD
+	fmt.Println(c.CashUnit())
+	fmt.Println(s.CashUnit())
+	fmt.Println(u.CashUnit())
+	// Output:
+	// 0.05
+	// 1
+	// 0.01
+}
+
+func ExampleCurrency_Symbol() {
+	u := money.USD
+	c := money.CAD
+	h := money.CHF
+	fmt.Println(u.Symbol())
+	fmt.Println(c.Symbol())
+	fmt.Println(h.Symbol())
+	// Output:
+	// $
+	// CA$
+	// CHF
+}
+
+func ExampleCurrency_NarrowSymbol() {
+	u := money.USD
+	c := money.CAD
+	fmt.Println(u.NarrowSymbol())
+	fmt.Println(c.NarrowSymbol())
+	// Output:
+	// $
+	// $
+}
+
+func ExampleCurrency_DisplayName() {
+	c := money.USD
+	fmt.Println(c.DisplayName(language.German))
+	fmt.Println(c.DisplayName(language.French))
+	fmt.Println(c.DisplayName(language.CanadianFrench))
+	fmt.Println(c.DisplayName(language.Swahili))
+	// Output:
+	// US-Dollar
+	// dollar des États-Unis
+	// dollar américain
+	// US Dollar
+}
+
+func ExampleCurrency_Countries() {
+	c := money.CHF
+	x := money.XAU
+	fmt.Println(c.Countries())
+	fmt.Println(x.Countries())
+	// Output:
+	// [CH LI]
+	// []
+}
+
+func ExampleCurrency_IsFund() {
+	u := money.USD
+	n := money.USN
+	fmt.Println(u.IsFund())
+	fmt.Println(n.IsFund())
+	// Output:
+	// false
+	// true
+}
+
 func ExampleCurrency_Scale() {
 	j := money.JPY
 	u := money.USD
@@ -964,6 +1071,22 @@ func ExampleNewAmountFromFloat64_scales() {
 	// USD 567.00 <nil>
 }
 
+func ExampleNewAmountFromProto() {
+	fmt.Println(money.NewAmountFromProto("USD", 5, 670_000_000))
+	fmt.Println(money.NewAmountFromProto("USD", -5, -670_000_000))
+	fmt.Println(money.NewAmountFromProto("USD", 5, 675_000_000))
+	// Output:
+	// USD 5.67 <nil>
+	// USD -5.67 <nil>
+	// XXX 0 converting nanos: 675000000 has more than 2 digits after the decimal point
+}
+
+func ExampleAmount_Proto() {
+	a := money.MustParseAmount("USD", "5.67")
+	fmt.Println(a.Proto())
+	// Output: USD 5 670000000 <nil>
+}
+
 func ExampleNewAmountFromMinorUnits_currencies() {
 	fmt.Println(money.NewAmountFromMinorUnits("JPY", 567))
 	fmt.Println(money.NewAmountFromMinorUnits("USD", 567))
@@ -1036,6 +1159,16 @@ func ExampleParseAmount_scales() {
 	// USD 567.00 <nil>
 }
 
+func ExampleParseShorthand() {
+	fmt.Println(money.ParseShorthand("USD", "5k"))
+	fmt.Println(money.ParseShorthand("USD", "1.2m"))
+	fmt.Println(money.ParseShorthand("USD", "3bn"))
+	// Output:
+	// USD 5000.00 <nil>
+	// USD 1200000.00 <nil>
+	// USD 3000000000.00 <nil>
+}
+
 func ExampleAmount_MinorUnits_currencies() {
 	a := money.MustParseAmount("JPY", "5.678")
 	b := money.MustParseAmount("USD", "5.678")
@@ -1068,6 +1201,33 @@ func ExampleAmount_MinorUnits_scales() {
 	// 56700 true
 }
 
+func ExampleNewAmountFromFloat64Round() {
+	f := 0.1
+	f += 0.2
+	fmt.Println(money.NewAmountFromFloat64("USD", f))
+	fmt.Println(money.NewAmountFromFloat64Round("USD", f))
+	fmt.Println(money.NewAmountFromFloat64Round("USD", 5.678, money.Floor))
+	fmt.Println(money.NewAmountFromFloat64Round("JPY", 5.678, money.Floor))
+	// Output:
+	// USD 0.30000000000000004 <nil>
+	// USD 0.30 <nil>
+	// USD 5.67 <nil>
+	// JPY 5 <nil>
+}
+
+func ExampleAmount_MajorMinor() {
+	a := money.MustParseAmount("JPY", "5.678")
+	b := money.MustParseAmount("USD", "-5.678")
+	c := money.MustParseAmount("OMR", "5.678")
+	fmt.Println(a.MajorMinor())
+	fmt.Println(b.MajorMinor())
+	fmt.Println(c.MajorMinor())
+	// Output:
+	// 6 0 true
+	// -5 -68 true
+	// 5 678 true
+}
+
 func ExampleAmount_Float64() {
 	a := money.MustParseAmount("USD", "0.10")
 	b := money.MustParseAmount("USD", "123.456")
@@ -1129,6 +1289,17 @@ func ExampleAmount_SubAbs() {
 	// Output: USD 17.33 <nil>
 }
 
+func ExampleAmount_SubOverdraw() {
+	balance := money.MustParseAmount("USD", "10.00")
+	a := money.MustParseAmount("USD", "5.67")
+	b := money.MustParseAmount("USD", "23.00")
+	fmt.Println(balance.SubOverdraw(a))
+	fmt.Println(balance.SubOverdraw(b))
+	// Output:
+	// USD 4.33 USD 0.00 <nil>
+	// USD -13.00 USD 13.00 <nil>
+}
+
 func ExampleAmount_AddMul() {
 	a := money.MustParseAmount("USD", "5.67")
 	b := money.MustParseAmount("USD", "23.00")
@@ -1153,6 +1324,32 @@ func ExampleAmount_SubMul() {
 	// Output: USD -40.33 <nil>
 }
 
+func ExampleAmount_Percent() {
+	a := money.MustParseAmount("USD", "10.00")
+	p := decimal.MustParse("2.9")
+	fmt.Println(a.Percent(p))
+	b := money.MustParseAmount("USD", "0.05")
+	q := decimal.MustParse("50")
+	fmt.Println(b.Percent(q, money.HalfEven))
+	fmt.Println(b.Percent(q, money.HalfUp))
+	// Output:
+	// USD 0.29 USD 9.71 <nil>
+	// USD 0.02 USD 0.03 <nil>
+	// USD 0.03 USD 0.02 <nil>
+}
+
+func ExampleAmount_Bps() {
+	a := money.MustParseAmount("USD", "1000.00")
+	fmt.Println(a.Bps(25))
+	b := money.MustParseAmount("USD", "1.00")
+	fmt.Println(b.Bps(1))
+	fmt.Println(b.Bps(1, money.Ceiling))
+	// Output:
+	// USD 2.50 USD 997.50 <nil>
+	// USD 0.00 USD 1.00 <nil>
+	// USD 0.01 USD 0.99 <nil>
+}
+
 func ExampleAmount_SubQuo() {
 	a := money.MustParseAmount("USD", "5.67")
 	b := money.MustParseAmount("USD", "23.00")
@@ -1168,6 +1365,26 @@ func ExampleAmount_Mul() {
 	// Output: USD 11.34 <nil>
 }
 
+func ExampleAmount_MulRound() {
+	a := money.MustParseAmount("USD", "2.50")
+	e := decimal.MustParse("0.05")
+	fmt.Println(a.MulRound(e))
+	fmt.Println(a.MulRound(e, money.HalfUp))
+	// Output:
+	// USD 0.12 <nil>
+	// USD 0.13 <nil>
+}
+
+func ExampleAmount_QuoRound() {
+	a := money.MustParseAmount("USD", "0.25")
+	e := decimal.MustParse("2")
+	fmt.Println(a.QuoRound(e))
+	fmt.Println(a.QuoRound(e, money.HalfUp))
+	// Output:
+	// USD 0.12 <nil>
+	// USD 0.13 <nil>
+}
+
 func ExampleAmount_Quo() {
 	a := money.MustParseAmount("USD", "5.67")
 	e := decimal.MustParse("2")
@@ -1223,6 +1440,28 @@ func ExampleAmount_Split_parts() {
 	// [USD 1.14 USD 1.14 USD 1.13 USD 1.13 USD 1.13] <nil>
 }
 
+func ExampleAmount_Allocate() {
+	a := money.MustParseAmount("USD", "0.05")
+	fmt.Println(a.Allocate(1, 1, 1))
+	fmt.Println(a.Allocate(1, 2))
+	fmt.Println(a.Allocate(70, 20, 10))
+	// Output:
+	// [USD 0.02 USD 0.02 USD 0.01] <nil>
+	// [USD 0.02 USD 0.03] <nil>
+	// [USD 0.04 USD 0.01 USD 0.00] <nil>
+}
+
+func ExampleAmount_AllocateSeeded() {
+	a := money.MustParseAmount("USD", "100.00")
+	fmt.Println(a.AllocateSeeded("INV-001", 1, 1, 1))
+	fmt.Println(a.AllocateSeeded("INV-001", 1, 1, 1))
+	fmt.Println(a.AllocateSeeded("INV-002", 1, 1, 1))
+	// Output:
+	// [USD 33.33 USD 33.34 USD 33.33] <nil>
+	// [USD 33.33 USD 33.34 USD 33.33] <nil>
+	// [USD 33.33 USD 33.33 USD 33.34] <nil>
+}
+
 func ExampleAmount_Rat() {
 	a := money.MustParseAmount("EUR", "8")
 	b := money.MustParseAmount("USD", "10")
@@ -1230,6 +1469,22 @@ func ExampleAmount_Rat() {
 	// Output: 0.8 <nil>
 }
 
+func ExampleAmount_DivMod() {
+	a := money.MustParseAmount("USD", "100.00")
+	fmt.Println(a.DivMod(3))
+	// Output: USD 33.33 USD 0.01 <nil>
+}
+
+func ExampleAmount_Ratio() {
+	a := money.MustParseAmount("USD", "1.00")
+	b := money.MustParseAmount("USD", "3.00")
+	fmt.Println(a.Ratio(b))
+	fmt.Println(a.Rat(b))
+	// Output:
+	// 1 3 <nil>
+	// 0.3333333333333333333 <nil>
+}
+
 func ExampleAmount_Rescale_currencies() {
 	a := money.MustParseAmount("JPY", "5.678")
 	b := money.MustParseAmount("USD", "5.678")
@@ -1299,6 +1554,46 @@ func ExampleAmount_RoundToCurr() {
 	// OMR 5.678
 }
 
+func ExampleAmount_RoundToCash() {
+	a := money.MustParseAmount("CHF", "12.34")
+	b := money.MustParseAmount("SEK", "12.34")
+	c := money.MustParseAmount("USD", "12.34")
+	fmt.Println(a.RoundToCash())
+	fmt.Println(b.RoundToCash())
+	fmt.Println(c.RoundToCash())
+	// Output:
+	// CHF 12.35 <nil>
+	// SEK 12.00 <nil>
+	// USD 12.34 <nil>
+}
+
+func ExampleAmount_RoundToUnit() {
+	a := money.MustParseAmount("USD", "12.34")
+	u := decimal.MustParse("0.25")
+	fmt.Println(a.RoundToUnit(u))
+	fmt.Println(a.RoundToUnit(u, money.Ceiling))
+	// Output:
+	// USD 12.25 <nil>
+	// USD 12.50 <nil>
+}
+
+func ExampleAmount_RoundWith() {
+	a := money.MustParseAmount("USD", "2.125")
+	fmt.Println(a.RoundWith(2, money.HalfEven))
+	fmt.Println(a.RoundWith(2, money.HalfUp))
+	fmt.Println(a.RoundWith(2, money.HalfDown))
+	fmt.Println(a.RoundWith(2, money.Ceiling))
+	fmt.Println(a.RoundWith(2, money.Floor))
+	fmt.Println(a.RoundWith(2, money.Truncate))
+	// Output:
+	// USD 2.12
+	// USD 2.13
+	// USD 2.12
+	// USD 2.13
+	// USD 2.12
+	// USD 2.12
+}
+
 func ExampleAmount_Quantize() {
 	a := money.MustParseAmount("JPY", "5.678")
 	x := money.MustParseAmount("JPY", "1")
@@ -1538,12 +1833,14 @@ func ExampleAmount_MinScale() {
 func ExampleAmount_Format_verbs() {
 	a := money.MustParseAmount("USD", "5.678")
 	fmt.Printf("%v\n", a)
+	fmt.Printf("%s\n", a)
 	fmt.Printf("%[1]f %[1]c\n", a)
 	fmt.Printf("%f\n", a)
 	fmt.Printf("%d\n", a)
 	fmt.Printf("%c\n", a)
 	// Output:
 	// USD 5.678
+	// $5.678
 	// 5.678 USD
 	// 5.678
 	// 568
@@ -1577,6 +1874,92 @@ func ExampleAmount_String() {
 	// EUR -0.010000
 }
 
+func ExampleAmount_OFXAmount() {
+	a := money.MustParseAmount("USD", "-1234.567")
+	b := money.MustParseAmount("JPY", "1234")
+	fmt.Println(a.OFXAmount())
+	fmt.Println(b.OFXAmount())
+	// Output:
+	// -1234.57
+	// 1234
+}
+
+func ExampleAmount_QIFAmount() {
+	a := money.MustParseAmount("USD", "-1234.567")
+	b := money.MustParseAmount("JPY", "1234")
+	fmt.Println(a.QIFAmount())
+	fmt.Println(b.QIFAmount())
+	// Output:
+	// -1,234.57
+	// 1,234
+}
+
+func ExampleAmount_SWIFTAmount() {
+	a := money.MustParseAmount("USD", "1234.5")
+	b := money.MustParseAmount("JPY", "1000")
+	fmt.Println(a.SWIFTAmount())
+	fmt.Println(b.SWIFTAmount())
+	// Output:
+	// USD1234,50 <nil>
+	// JPY1000, <nil>
+}
+
+func ExampleParseSWIFTAmount() {
+	fmt.Println(money.ParseSWIFTAmount("USD1234,5"))
+	fmt.Println(money.ParseSWIFTAmount("JPY1000,"))
+	fmt.Println(money.ParseSWIFTAmount("USD1234,567"))
+	// Output:
+	// USD 1234.50 <nil>
+	// JPY 1000 <nil>
+	// XXX 0 parsing SWIFT amount "USD1234,567": amount has more than 2 digits after the decimal point
+}
+
+type CreditTransfer struct {
+	InstdAmt money.ISO20022Amount `xml:"InstdAmt"`
+}
+
+func ExampleISO20022Amount_MarshalXML() {
+	t := CreditTransfer{
+		InstdAmt: money.ISO20022Amount{Amount: money.MustParseAmount("EUR", "1234.5")},
+	}
+	b, err := xml.Marshal(t)
+	fmt.Println(string(b), err)
+	// Output: <CreditTransfer><InstdAmt Ccy="EUR">1234.50</InstdAmt></CreditTransfer> <nil>
+}
+
+func ExampleISO20022Amount_UnmarshalXML() {
+	var t CreditTransfer
+	err := xml.Unmarshal([]byte(`<CreditTransfer><InstdAmt Ccy="EUR">1234.5</InstdAmt></CreditTransfer>`), &t)
+	fmt.Println(t.InstdAmt.Amount, err)
+	// Output: EUR 1234.50 <nil>
+}
+
+func ExampleAmount_FormatTAccount() {
+	a := money.MustParseAmount("USD", "1234.56")
+	b := money.MustParseAmount("USD", "-5.67")
+	c := money.MustParseAmount("USD", "0.00")
+	fmt.Printf("| %-10v | %-10v |\n", "Debit", "Credit")
+	for _, x := range []money.Amount{a, b, c} {
+		debit, credit := x.FormatTAccount(language.English)
+		fmt.Printf("| %10v | %10v |\n", debit, credit)
+	}
+	// Output:
+	// | Debit      | Credit     |
+	// |  $1,234.56 |            |
+	// |            |      $5.67 |
+	// |            |            |
+}
+
+func ExampleAmount_FormatTrimWhole() {
+	a := money.MustParseAmount("USD", "5.00")
+	b := money.MustParseAmount("USD", "5.50")
+	fmt.Println(a.FormatTrimWhole(language.English))
+	fmt.Println(b.FormatTrimWhole(language.English))
+	// Output:
+	// $5
+	// $5.50
+}
+
 func ExampleAmount_Abs() {
 	a := money.MustParseAmount("USD", "-5.67")
 	fmt.Println(a.Abs())
@@ -1684,6 +2067,32 @@ func ExampleAmount_IsPos() {
 	// false
 }
 
+func ExampleAmount_EnsurePos() {
+	a := money.MustParseAmount("USD", "-5.67")
+	b := money.MustParseAmount("USD", "0")
+	c := money.MustParseAmount("USD", "5.67")
+	fmt.Println(a.EnsurePos())
+	fmt.Println(b.EnsurePos())
+	fmt.Println(c.EnsurePos())
+	// Output:
+	// amount must be positive, got USD -5.67
+	// amount must be positive, got USD 0.00
+	// <nil>
+}
+
+func ExampleAmount_EnsureNonNeg() {
+	a := money.MustParseAmount("USD", "-5.67")
+	b := money.MustParseAmount("USD", "0")
+	c := money.MustParseAmount("USD", "5.67")
+	fmt.Println(a.EnsureNonNeg())
+	fmt.Println(b.EnsureNonNeg())
+	fmt.Println(c.EnsureNonNeg())
+	// Output:
+	// amount must be non-negative, got USD -5.67
+	// <nil>
+	// <nil>
+}
+
 func ExampleAmount_Zero() {
 	a := money.MustParseAmount("JPY", "5")
 	b := money.MustParseAmount("JPY", "5.6")
@@ -2381,3 +2790,995 @@ func ExampleExchangeRate_Ceil_scales() {
 	// EUR/USD 5.679 <nil>
 	// EUR/USD 5.6789 <nil>
 }
+
+type RateMap map[string]money.ExchangeRate
+
+func (m RateMap) ExchRate(base, quote money.Currency) (money.ExchangeRate, error) {
+	r, ok := m[base.Code()+"/"+quote.Code()]
+	if !ok {
+		return money.ExchangeRate{}, fmt.Errorf("%v/%v rate not found", base, quote)
+	}
+	return r, nil
+}
+
+func ExampleConvRoundTrip() {
+	rates := RateMap{
+		"EUR/USD": money.MustParseExchRate("EUR", "USD", "1.0995"),
+		"USD/EUR": money.MustParseExchRate("USD", "EUR", "0.9095"),
+	}
+	a := money.MustParseAmount("EUR", "100.00")
+	conv, back, _ := money.ConvRoundTrip(a, "USD", rates)
+	loss, _ := a.Sub(back)
+	fmt.Println(conv)
+	fmt.Println(back)
+	fmt.Println(loss.RoundToCurr())
+	// Output:
+	// USD 109.950000
+	// EUR 99.9995250000
+	// EUR 0.00
+}
+
+func ExampleFormatter_Format() {
+	a := money.MustParseAmount("EUR", "1234.56")
+	for _, tag := range []string{"en-US", "de-DE", "fr-FR", "nl-NL"} {
+		f := money.MustNewFormatter(tag)
+		fmt.Printf("%q\n", f.Format(a))
+	}
+	// Output:
+	// "€1,234.56"
+	// "1.234,56\u00a0€"
+	// "1\u202f234,56\u00a0€"
+	// "€\u00a01.234,56"
+}
+
+func ExampleFormatter_AppendFormat() {
+	f := money.MustNewFormatter("en-US")
+	text := make([]byte, 0, 64)
+	for _, s := range []string{"0.05", "1234.5", "-99.999"} {
+		text = append(text[:0], "Total: "...)
+		text = f.AppendFormat(text, money.MustParseAmount("USD", s))
+		fmt.Println(string(text))
+	}
+	// Output:
+	// Total: $0.05
+	// Total: $1,234.50
+	// Total: -$100.00
+}
+
+func ExampleFormatter_Parse() {
+	f := money.MustNewFormatter("en-US")
+	fmt.Println(f.Parse("$1,234.56", money.XXX))
+	fmt.Println(f.Parse("1.234,56 EUR", money.XXX))
+	fmt.Println(f.Parse("(45.00)", money.USD))
+	g := money.MustNewFormatter("de-DE")
+	fmt.Println(g.Parse("1.234", money.EUR))
+	// Output:
+	// USD 1234.56 <nil>
+	// EUR 1234.56 <nil>
+	// USD -45.00 <nil>
+	// EUR 1234.00 <nil>
+}
+
+func ExampleAmount_Scan() {
+	var a, b money.Amount
+	_ = a.Scan("USD 12.34")
+	// PostgreSQL composite type:
+	//
+	//	CREATE TYPE money_amount AS (curr char(3), amount numeric);
+	//	SELECT ('USD', 12.34)::money_amount;
+	_ = b.Scan("(USD,12.34)")
+	fmt.Println(a)
+	fmt.Println(b)
+	// Output:
+	// USD 12.34
+	// USD 12.34
+}
+
+func ExampleAmount_Value() {
+	a := money.MustParseAmount("USD", "12.34")
+	fmt.Println(a.Value())
+	// Output: USD 12.34 <nil>
+}
+
+func ExampleAmount_Value_numeric() {
+	// Storing an amount in two columns:
+	//
+	//	CREATE TABLE payments (curr char(3), amount numeric);
+	a := money.MustParseAmount("USD", "12.34")
+	fmt.Println(a.Curr().Value())
+	fmt.Println(a.Decimal().Value())
+
+	// Scanning it back
+	var c money.Currency
+	var d decimal.Decimal
+	_ = c.Scan("USD")
+	_ = d.Scan("12.34")
+	fmt.Println(money.NewAmountFromDecimal(c, d))
+	// Output:
+	// USD <nil>
+	// 12.34 <nil>
+	// USD 12.34 <nil>
+}
+
+func ExampleAmount_MarshalBSONValue_bson() {
+	a := money.MustParseAmount("USD", "12.34")
+	typ, data, err := a.MarshalBSONValue()
+	fmt.Printf("%v [% x] %v\n", typ, data, err)
+	// Output:
+	// 3 [2f 00 00 00 13 61 6d 6f 75 6e 74 00 d2 04 00 00 00 00 00 00 00 00 00 00 00 00 3c 30 02 63 75 72 72 65 6e 63 79 00 04 00 00 00 55 53 44 00 00] <nil>
+}
+
+func ExampleAmount_UnmarshalBSONValue_bson() {
+	// Legacy document with minor units:
+	//
+	//	{amount: NumberLong(1234), currency: "USD"}
+	data := []byte{
+		0x27, 0x00, 0x00, 0x00,
+		0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x00,
+		0xd2, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x02, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x00,
+		0x04, 0x00, 0x00, 0x00, 0x55, 0x53, 0x44, 0x00,
+		0x00,
+	}
+
+	var a money.Amount
+	err := a.UnmarshalBSONValue(3, data)
+	fmt.Println(a, err)
+	// Output:
+	// USD 12.34 <nil>
+}
+
+func ExampleNullAmount_Scan() {
+	var n, m money.NullAmount
+	_ = n.Scan("USD 12.34")
+	_ = m.Scan(nil)
+	fmt.Println(n)
+	fmt.Println(m)
+	// Output:
+	// {USD 12.34 true}
+	// {XXX 0 false}
+}
+
+func ExampleNullAmount_Value() {
+	n := money.NullAmount{
+		Amount: money.MustParseAmount("USD", "12.34"),
+		Valid:  true,
+	}
+	m := money.NullAmount{}
+	fmt.Println(n.Value())
+	fmt.Println(m.Value())
+	// Output:
+	// USD 12.34 <nil>
+	// <nil> <nil>
+}
+
+func ExampleNewMinorUnits() {
+	// Storing an amount in two columns:
+	//
+	//	CREATE TABLE payments (curr char(3), units bigint);
+	a := money.MustParseAmount("USD", "12.34")
+	b := money.MustParseAmount("USD", "12.345")
+	fmt.Println(money.NewMinorUnits(a))
+	fmt.Println(money.NewMinorUnits(b))
+	// Output:
+	// {USD 1234} <nil>
+	// {XXX 0} converting USD 12.345 to minor units: amount has more than 2 digits after the decimal point
+}
+
+func ExampleMinorUnits_Amount() {
+	m := money.MinorUnits{Curr: money.USD, Units: 1234}
+	fmt.Println(m.Amount())
+	// Output: USD 12.34 <nil>
+}
+
+// LTC is registered once during program initialization.
+var LTC = money.MustRegisterCurr("LTC", "", 8)
+
+func ExampleRegisterCurr() {
+	a := money.MustParseAmount("LTC", "0.5")
+	b, _ := a.Mul(decimal.MustParse("0.00012345"))
+	fmt.Println(LTC, LTC.Scale())
+	fmt.Println(a)
+	fmt.Println(b.RoundToCurr())
+	// Output:
+	// LTC 8
+	// LTC 0.50000000
+	// LTC 0.00006172
+}
+
+// FRF is registered once during program initialization.
+var FRF = money.MustRegisterHistoricalCurr("FRF")
+
+func ExampleRegisterHistoricalCurr() {
+	a := money.MustParseAmount("FRF", "100")
+	r := money.MustParseExchRate("EUR", "FRF", "6.55957")
+	q, _ := r.Inv()
+	b, _ := q.Conv(a)
+	fmt.Println(FRF, FRF.Num(), FRF.Scale())
+	fmt.Println(a)
+	fmt.Println(b.RoundToCurr())
+	// Output:
+	// FRF 250 2
+	// FRF 100.00
+	// EUR 15.24
+}
+
+func ExampleAmount_MarshalBinary_gob() {
+	a := money.MustParseAmount("USD", "12.34")
+	var data bytes.Buffer
+	err := gob.NewEncoder(&data).Encode(a)
+	fmt.Println(err)
+	var b money.Amount
+	err = gob.NewDecoder(&data).Decode(&b)
+	fmt.Println(b, err)
+	// Output:
+	// <nil>
+	// USD 12.34 <nil>
+}
+
+func ExampleAmount_MarshalText_xml() {
+	type Payment struct {
+		Total money.Amount `xml:"total,attr"`
+	}
+	v := Payment{Total: money.MustParseAmount("USD", "12.34")}
+	text, err := xml.Marshal(v)
+	fmt.Println(string(text), err)
+	// Output: <Payment total="USD 12.34"></Payment> <nil>
+}
+
+type Invoice struct {
+	Total    money.Amount     `json:"total"`
+	Label    money.TextAmount `json:"label"`
+	Captured money.MinorUnits `json:"captured"`
+}
+
+func ExampleAmount_MarshalJSON_json() {
+	a := money.MustParseAmount("USD", "12.34")
+	m, _ := money.NewMinorUnits(a)
+	v := Invoice{
+		Total:    a,
+		Label:    money.TextAmount{Amount: a},
+		Captured: m,
+	}
+	b, err := json.Marshal(v)
+	fmt.Println(string(b), err)
+	// Output:
+	// {"total":{"amount":"12.34","currency":"USD"},"label":"USD 12.34","captured":{"currency":"USD","minor_units":1234}} <nil>
+}
+
+func ExampleAmount_UnmarshalJSON_json() {
+	var v Invoice
+	err := json.Unmarshal([]byte(`{"total":{"amount":"12.34","currency":"USD"},"label":"USD 12.34","captured":{"currency":"USD","minor_units":1234}}`), &v)
+	fmt.Println(v.Total, v.Label.Amount, err)
+	fmt.Println(v.Captured.Amount())
+	// Output:
+	// USD 12.34 USD 12.34 <nil>
+	// USD 12.34 <nil>
+}
+
+func ExampleAmount_MarshalYAML_yaml() {
+	a := money.MustParseAmount("USD", "9.99")
+	v, err := a.MarshalYAML()
+	fmt.Printf("limit: %v %v\n", v, err)
+	// Output:
+	// limit: USD 9.99 <nil>
+}
+
+func ExampleAmount_UnmarshalYAML_yaml() {
+	// A YAML package calls UnmarshalYAML with a function that decodes
+	// the node, here the scalar of the following document:
+	//
+	//	limit: 9.99 USD
+	unmarshal := func(v any) error {
+		s, ok := v.(*string)
+		if !ok {
+			return fmt.Errorf("cannot unmarshal scalar into %T", v)
+		}
+		*s = "9.99 USD"
+		return nil
+	}
+	var a money.Amount
+	err := a.UnmarshalYAML(unmarshal)
+	fmt.Println(a, err)
+	// Output:
+	// USD 9.99 <nil>
+}
+
+func ExampleNullAmount_MarshalJSON_json() {
+	n := money.NullAmount{}
+	m := money.NullAmount{
+		Amount: money.MustParseAmount("USD", "12.34"),
+		Valid:  true,
+	}
+	text, err := json.Marshal(n)
+	fmt.Println(string(text), err)
+	text, err = json.Marshal(m)
+	fmt.Println(string(text), err)
+	// Output:
+	// null <nil>
+	// {"amount":"12.34","currency":"USD"} <nil>
+}
+
+func ExampleNewRange() {
+	min := money.MustParseAmount("USD", "10.00")
+	max := money.MustParseAmount("USD", "500.00")
+	fmt.Println(money.NewRange(min, max))
+	fmt.Println(money.NewRange(max, min))
+	// Output:
+	// [USD 10.00, USD 500.00] <nil>
+	// [XXX 0, XXX 0] creating range: USD 500.00 is greater than USD 10.00
+}
+
+func ExampleRange_Contains() {
+	topUp := money.MustNewRange(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "500.00"),
+	)
+	fmt.Println(topUp.Contains(money.MustParseAmount("USD", "5.00")))
+	fmt.Println(topUp.Contains(money.MustParseAmount("USD", "10.00")))
+	fmt.Println(topUp.Contains(money.MustParseAmount("USD", "750.00")))
+	// Output:
+	// false <nil>
+	// true <nil>
+	// false <nil>
+}
+
+func ExampleRange_Clamp() {
+	r := money.MustNewRange(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "500.00"),
+	)
+	fmt.Println(r.Clamp(money.MustParseAmount("USD", "5.00")))
+	fmt.Println(r.Clamp(money.MustParseAmount("USD", "42.00")))
+	fmt.Println(r.Clamp(money.MustParseAmount("USD", "750.00")))
+	// Output:
+	// USD 10.00 <nil>
+	// USD 42.00 <nil>
+	// USD 500.00 <nil>
+}
+
+func ExampleRange_Overlaps() {
+	r := money.MustNewRange(
+		money.MustParseAmount("USD", "0.00"),
+		money.MustParseAmount("USD", "99.99"),
+	)
+	s := money.MustNewRange(
+		money.MustParseAmount("USD", "100.00"),
+		money.MustParseAmount("USD", "999.99"),
+	)
+	t := money.MustNewRange(
+		money.MustParseAmount("USD", "50.00"),
+		money.MustParseAmount("USD", "150.00"),
+	)
+	fmt.Println(r.Overlaps(s))
+	fmt.Println(r.Overlaps(t))
+	// Output:
+	// false <nil>
+	// true <nil>
+}
+
+func ExampleRange_Intersect() {
+	r := money.MustNewRange(
+		money.MustParseAmount("USD", "0.00"),
+		money.MustParseAmount("USD", "99.99"),
+	)
+	s := money.MustNewRange(
+		money.MustParseAmount("USD", "50.00"),
+		money.MustParseAmount("USD", "150.00"),
+	)
+	fmt.Println(r.Intersect(s))
+	// Output: [USD 50.00, USD 99.99] <nil>
+}
+
+func ExampleRange_MarshalJSON() {
+	r := money.MustNewRange(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "500.00"),
+	)
+	b, err := json.Marshal(r)
+	fmt.Println(string(b), err)
+	// Output: {"currency":"USD","min":"10.00","max":"500.00"} <nil>
+}
+
+func ExampleRange_UnmarshalJSON() {
+	var r money.Range
+	err := json.Unmarshal([]byte(`{"currency":"USD","min":"10.00","max":"500.00"}`), &r)
+	fmt.Println(r, err)
+	// Output: [USD 10.00, USD 500.00] <nil>
+}
+
+func ExampleNewBasket() {
+	b, err := money.NewBasket(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("EUR", "5.00"),
+		money.MustParseAmount("USD", "2.50"),
+	)
+	fmt.Println(b, err)
+	// Output: [EUR 5.00, USD 12.50] <nil>
+}
+
+func ExampleBasket_Add() {
+	b := money.MustNewBasket(money.MustParseAmount("USD", "10.00"))
+	fmt.Println(b.Add(money.MustParseAmount("USD", "2.50"), money.MustParseAmount("JPY", "500")))
+	// Output: [JPY 500, USD 12.50] <nil>
+}
+
+func ExampleBasket_Sub() {
+	b := money.MustNewBasket(money.MustParseAmount("USD", "10.00"))
+	fmt.Println(b.Sub(money.MustParseAmount("USD", "10.00"), money.MustParseAmount("JPY", "500")))
+	// Output: [JPY -500, USD 0.00] <nil>
+}
+
+func ExampleBasket_IsZero() {
+	b := money.MustNewBasket(money.MustParseAmount("USD", "0.00"))
+	c := money.MustNewBasket(money.MustParseAmount("USD", "0.00"), money.MustParseAmount("EUR", "1.00"))
+	fmt.Println(b.IsZero())
+	fmt.Println(c.IsZero())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleBasket_Amounts() {
+	b := money.MustNewBasket(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("EUR", "5.00"),
+		money.MustParseAmount("JPY", "500"),
+	)
+	for _, a := range b.Amounts() {
+		fmt.Println(a)
+	}
+	// Output:
+	// EUR 5.00
+	// JPY 500
+	// USD 10.00
+}
+
+func ExampleBasket_Amount() {
+	b := money.MustNewBasket(money.MustParseAmount("USD", "10.00"))
+	fmt.Println(b.Amount(money.USD))
+	fmt.Println(b.Amount(money.EUR))
+	// Output:
+	// USD 10.00
+	// EUR 0.00
+}
+
+func ExampleBasket_Currs() {
+	b := money.MustNewBasket(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("EUR", "5.00"),
+	)
+	fmt.Println(b.Currs())
+	// Output: [EUR USD]
+}
+
+func ExampleBasket_MarshalJSON() {
+	b := money.MustNewBasket(
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("EUR", "5.00"),
+	)
+	text, err := json.Marshal(b)
+	fmt.Println(string(text), err)
+	// Output: {"EUR":"5.00","USD":"10.00"} <nil>
+}
+
+func ExampleBasket_UnmarshalJSON() {
+	var b money.Basket
+	err := json.Unmarshal([]byte(`{"USD":"10.00","EUR":"5.00"}`), &b)
+	fmt.Println(b, err)
+	// Output: [EUR 5.00, USD 10.00] <nil>
+}
+
+func ExampleSum() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "2.50"),
+		money.MustParseAmount("USD", "-0.75"),
+	}
+	fmt.Println(money.Sum(slices.Values(amounts)))
+	// Output: USD 11.75 <nil>
+}
+
+func ExampleMean() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "1.00"),
+		money.MustParseAmount("USD", "1.00"),
+		money.MustParseAmount("USD", "2.00"),
+	}
+	fmt.Println(money.Mean(slices.Values(amounts)))
+	fmt.Println(money.Mean(slices.Values(amounts), money.Ceiling))
+	// Output:
+	// USD 1.33 <nil>
+	// USD 1.34 <nil>
+}
+
+func ExampleMin() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "-0.75"),
+	}
+	fmt.Println(money.Min(slices.Values(amounts)))
+	// Output: USD -0.75 <nil>
+}
+
+func ExampleMax() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "-0.75"),
+	}
+	fmt.Println(money.Max(slices.Values(amounts)))
+	// Output: USD 10.00 <nil>
+}
+
+func ExampleTotal() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "2.50"),
+		money.MustParseAmount("USD", "-0.75"),
+	}
+	fmt.Println(money.Total(amounts))
+	// Output: USD 11.75 <nil>
+}
+
+func ExampleMinOf() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "-0.75"),
+	}
+	fmt.Println(money.MinOf(amounts))
+	// Output: USD -0.75 <nil>
+}
+
+func ExampleMaxOf() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("USD", "-0.75"),
+	}
+	fmt.Println(money.MaxOf(amounts))
+	// Output: USD 10.00 <nil>
+}
+
+func ExampleClampSlice() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "-5.00"),
+		money.MustParseAmount("USD", "50.00"),
+		money.MustParseAmount("USD", "500.00"),
+	}
+	lo := money.MustParseAmount("USD", "0.00")
+	hi := money.MustParseAmount("USD", "100.00")
+	err := money.ClampSlice(amounts, lo, hi)
+	fmt.Println(amounts, err)
+	// Output: [USD 0.00 USD 50.00 USD 100.00] <nil>
+}
+
+func ExampleSummer() {
+	var s money.Summer
+	for _, a := range []money.Amount{
+		money.MustParseAmount("USD", "10.00"),
+		money.MustParseAmount("EUR", "5.00"),
+		money.MustParseAmount("USD", "2.50"),
+	} {
+		if err := s.Add(a); err != nil {
+			fmt.Println(err)
+		}
+	}
+	fmt.Println(s.Count())
+	fmt.Println(s.Sum())
+	// Output:
+	// computing sum: computing [USD 10.00 + EUR 5.00]: currency mismatch
+	// 2
+	// USD 12.50 <nil>
+}
+
+// statusCode maps errors of the money package to HTTP status codes.
+func statusCode(err error) int {
+	var ue *money.UnknownCurrencyError
+	var ae *money.InvalidAmountError
+	switch {
+	case err == nil:
+		return 200
+	case errors.As(err, &ue), errors.As(err, &ae):
+		return 400
+	case errors.Is(err, money.ErrCurrencyMismatch), errors.Is(err, money.ErrOverflow):
+		return 422
+	default:
+		return 500
+	}
+}
+
+func ExampleErrOverflow() {
+	a := money.MustParseAmount("USD", "99999999999999999")
+	_, err := a.Add(a)
+	fmt.Println(errors.Is(err, money.ErrOverflow), statusCode(err))
+	// Output: true 422
+}
+
+func ExampleErrCurrencyMismatch() {
+	a := money.MustParseAmount("USD", "1.00")
+	b := money.MustParseAmount("EUR", "1.00")
+	_, err := a.Add(b)
+	fmt.Println(errors.Is(err, money.ErrCurrencyMismatch), statusCode(err))
+	// Output: true 422
+}
+
+func ExampleUnknownCurrencyError() {
+	_, err := money.ParseAmount("ABC", "1.00")
+	var e *money.UnknownCurrencyError
+	if errors.As(err, &e) {
+		fmt.Println(e.Code, statusCode(err))
+	}
+	// Output: ABC 400
+}
+
+func ExampleInvalidAmountError() {
+	_, err := money.ParseAmount("USD", "1.0O")
+	var e *money.InvalidAmountError
+	if errors.As(err, &e) {
+		fmt.Println(e.Input, statusCode(err))
+	}
+	// Output: 1.0O 400
+}
+
+func ExampleCalc() {
+	price := money.MustParseAmount("USD", "10.00")
+
+	// Rounding every step loses a cent
+	third, _ := price.QuoRound(decimal.MustParse("3"), money.HalfEven)
+	step, _ := third.MulRound(decimal.MustParse("3"), money.HalfEven)
+	fmt.Println(step)
+
+	// Rounding only the final result does not
+	total, _ := money.NewCalc(price).MulRatio(1, 3).Mul(decimal.MustParse("3")).Result(money.HalfEven)
+	fmt.Println(total)
+	// Output:
+	// USD 9.99
+	// USD 10.00
+}
+
+func ExampleCalc_AddPercent() {
+	price := money.MustParseAmount("USD", "19.99")
+	total, err := money.NewCalc(price).
+		Mul(decimal.MustParse("3")).
+		AddPercent(decimal.MustParse("-15")).
+		AddPercent(decimal.MustParse("7.25")).
+		Result(money.HalfUp)
+	fmt.Println(total, err)
+	// Output: USD 54.67 <nil>
+}
+
+func ExampleCalc_Result() {
+	a := money.MustParseAmount("USD", "1.00")
+	b := money.MustParseAmount("EUR", "1.00")
+	_, err := money.NewCalc(a).Add(b).MulRatio(1, 2).Result()
+	fmt.Println(errors.Is(err, money.ErrCurrencyMismatch))
+	// Output: true
+}
+
+func ExampleCalc_Audit() {
+	price := money.MustParseAmount("USD", "19.99")
+	total, steps, err := money.NewAuditedCalc(price).
+		Mul(decimal.MustParse("3")).
+		AddPercent(decimal.MustParse("-15")).
+		AddPercent(decimal.MustParse("7.25")).
+		Audit(money.HalfUp)
+	if err != nil {
+		panic(err)
+	}
+	for _, s := range steps {
+		fmt.Println(s)
+	}
+	fmt.Println(total)
+	// Output:
+	// USD 19.99 * 3 = USD 59.97
+	// USD 59.97 + -15% = USD 50.9745
+	// USD 50.9745 + 7.25% = USD 54.67015125
+	// USD 54.67015125 rounded HalfUp = USD 54.67, residual USD 0.00015125
+	// USD 54.67
+}
+
+func ExampleReplay() {
+	price := money.MustParseAmount("USD", "10.00")
+	_, steps, err := money.NewAuditedCalc(price).MulRatio(1, 3).Audit()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(money.Replay(steps))
+	steps[0].Den = 4 // tampered record
+	_, err = money.Replay(steps)
+	fmt.Println(err != nil)
+	// Output:
+	// USD 3.33 <nil>
+	// true
+}
+
+func ExampleCompare() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "5.00"),
+		money.MustParseAmount("EUR", "7.00"),
+		money.MustParseAmount("USD", "-3.00"),
+		money.MustParseAmount("EUR", "2.00"),
+	}
+	slices.SortFunc(amounts, money.Compare)
+	fmt.Println(amounts)
+	// Output: [EUR 2.00 EUR 7.00 USD -3.00 USD 5.00]
+}
+
+func ExampleSortAscending() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "5.00"),
+		money.MustParseAmount("USD", "-3.00"),
+		money.MustParseAmount("USD", "1.00"),
+	}
+	err := money.SortAscending(amounts)
+	fmt.Println(amounts, err)
+	// Output: [USD -3.00 USD 1.00 USD 5.00] <nil>
+}
+
+func ExampleSortDescending() {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "5.00"),
+		money.MustParseAmount("USD", "-3.00"),
+		money.MustParseAmount("EUR", "1.00"),
+	}
+	err := money.SortDescending(amounts)
+	fmt.Println(amounts, err)
+	// Output: [USD 5.00 USD -3.00 EUR 1.00] sorting amounts: [USD 5.00] and [EUR 1.00]: currency mismatch
+}
+
+// ESP is registered and given a finer scale once during program
+// initialization, so that archival ledgers can keep fractions of a peseta.
+var ESP = func() money.Currency {
+	c := money.MustRegisterHistoricalCurr("ESP")
+	if err := money.SetCurrScale(c, 2); err != nil {
+		panic(err)
+	}
+	return c
+}()
+
+func ExampleSetCurrScale() {
+	a := money.MustParseAmount("ESP", "166.386")
+	b, _ := a.QuoRound(decimal.MustParse("3"), money.HalfEven)
+	fmt.Println(ESP.Scale())
+	fmt.Println(b)
+	// Output:
+	// 2
+	// ESP 55.46
+}
+
+func ExampleFormatter_WithScale() {
+	a := money.MustParseAmount("JPY", "1234.567")
+	f := money.MustNewFormatter("en-US")
+	fees, _ := f.WithScale(money.JPY, 2)
+	fmt.Println(f.Format(a))
+	fmt.Println(fees.Format(a))
+	// Output:
+	// ¥1,235
+	// ¥1,234.57
+}
+
+func ExampleAmount_Key() {
+	seen := make(map[money.AmountKey]bool)
+	for _, s := range []string{"10", "10.0", "10.00", "10.01"} {
+		a := money.MustParseAmount("USD", s)
+		if seen[a.Key()] {
+			fmt.Println("duplicate", a)
+			continue
+		}
+		seen[a.Key()] = true
+	}
+	fmt.Println(len(seen))
+	// Output:
+	// duplicate USD 10.00
+	// duplicate USD 10.00
+	// 2
+}
+
+func ExampleAmountKey_Amount() {
+	a := money.MustParseAmount("USD", "5.5000")
+	fmt.Println(a.Key().Amount())
+	// Output: USD 5.50
+}
+
+func ExampleAmount_AppendCanonical() {
+	a := money.MustParseAmount("USD", "12.340")
+	fmt.Printf("% x\n", a.AppendCanonical(nil))
+	// Output: 55 53 44 00 00 00 00 00 00 00 04 d2 02
+}
+
+func ExampleAmount_Hash() {
+	a := money.MustParseAmount("USD", "12.34")
+	b := money.MustParseAmount("USD", "12.340")
+	fmt.Println(a.Hash() == b.Hash())
+	// Output: true
+}
+
+func ExampleAmount_Generate() {
+	// Negation does not change the absolute value of an amount.
+	f := func(a money.Amount) bool {
+		return a.Neg().Abs() == a.Abs()
+	}
+	cfg := &quick.Config{Rand: rand.New(rand.NewSource(1))}
+	fmt.Println(quick.Check(f, cfg))
+	// Output: <nil>
+}
+
+func ExampleCheckBalanced() {
+	// Sale of goods for USD 100.00 with USD 7.25 of sales tax
+	entries := []money.Entry{
+		money.MustNewEntry(money.Debit, money.MustParseAmount("USD", "107.25")),  // cash
+		money.MustNewEntry(money.Credit, money.MustParseAmount("USD", "100.00")), // revenue
+		money.MustNewEntry(money.Credit, money.MustParseAmount("USD", "7.25")),   // tax payable
+	}
+	fmt.Println(money.CheckBalanced(slices.Values(entries)))
+	fmt.Println(money.CheckBalanced(slices.Values(entries[:2])))
+	// Output:
+	// <nil>
+	// checking balance: net balance [USD 7.25]: entries are not balanced
+}
+
+func ExampleBalance() {
+	entries := []money.Entry{
+		money.MustNewEntry(money.Debit, money.MustParseAmount("USD", "10.00")),
+		money.MustNewEntry(money.Credit, money.MustParseAmount("EUR", "9.00")),
+	}
+	b, err := money.Balance(slices.Values(entries))
+	fmt.Println(b, err)
+	// Output: [EUR -9.00, USD 10.00] <nil>
+}
+
+func ExampleNewEntryFromSigned() {
+	for _, s := range []string{"15.00", "-15.00"} {
+		e := money.NewEntryFromSigned(money.MustParseAmount("USD", s))
+		fmt.Println(e, e.Signed())
+	}
+	// Output:
+	// Debit USD 15.00 USD 15.00
+	// Credit USD 15.00 USD -15.00
+}
+
+func ExampleEntry_SignedFor() {
+	// Taking a loan: cash increases, and so does the loan liability
+	cash := money.MustNewEntry(money.Debit, money.MustParseAmount("USD", "500.00"))
+	loan := cash.Reverse()
+	fmt.Println(cash.SignedFor(money.Debit))  // asset account
+	fmt.Println(loan.SignedFor(money.Credit)) // liability account
+	// Output:
+	// USD 500.00
+	// USD 500.00
+}
+
+func ExampleFormatter_WithPattern() {
+	f := money.MustNewFormatter("en-US")
+	for _, p := range []string{
+		"¤#,##0.00;(¤#,##0.00)", // accounting parentheses
+		"#,##0.00 ¤¤",           // currency code
+		"¤#,##0.##",             // no trailing zeros
+	} {
+		g, err := f.WithPattern(p)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(g.Format(money.MustParseAmount("USD", "-1234.50")))
+	}
+	// Output:
+	// ($1,234.50)
+	// -1,234.50 USD
+	// -$1,234.5
+}
+
+func ExampleFormatter_WithNegativeStyle() {
+	a := money.MustParseAmount("USD", "-1234.56")
+	f := money.MustNewFormatter("en-US")
+	for _, style := range []money.NegativeStyle{
+		money.NegativeMinus,
+		money.NegativeParens,
+		money.NegativeTrailingMinus,
+		money.NegativeCreditDebit,
+	} {
+		g, err := f.WithNegativeStyle(style)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%q\n", g.Format(a))
+	}
+	// Output:
+	// "-$1,234.56"
+	// "($1,234.56)"
+	// "$1,234.56-"
+	// "$1,234.56\u00a0CR"
+}
+
+func ExampleFormatter_Parse_accounting() {
+	f := money.MustNewFormatter("en-US")
+	for _, s := range []string{"(1,234.56)", "1234.56-", "1,234.56 CR", "1,234.56 DR"} {
+		fmt.Println(f.Parse(s, money.USD))
+	}
+	// Output:
+	// USD -1234.56 <nil>
+	// USD -1234.56 <nil>
+	// USD -1234.56 <nil>
+	// USD 1234.56 <nil>
+}
+
+func ExampleRunningBalance() {
+	var b money.RunningBalance
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 25 {
+				_ = b.Add(money.MustParseAmount("USD", "0.10"))
+			}
+		}()
+	}
+	wg.Wait()
+	fmt.Println(b.Snapshot())
+	// Output: USD 10.00
+}
+
+func ExampleNewRunningBalance() {
+	b := money.NewRunningBalance(money.EUR)
+	err := b.Add(money.MustParseAmount("USD", "1.00"))
+	fmt.Println(b.Snapshot(), errors.Is(err, money.ErrCurrencyMismatch))
+	// Output: EUR 0.00 true
+}
+
+func ExampleAmount_ProviderUnits() {
+	for _, a := range []money.Amount{
+		money.MustParseAmount("USD", "123.45"),
+		money.MustParseAmount("JPY", "500"),
+		money.MustParseAmount("ISK", "500"),
+	} {
+		fmt.Println(a.ProviderUnits(money.Stripe))
+	}
+	// Output:
+	// 12345 <nil>
+	// 500 <nil>
+	// 50000 <nil>
+}
+
+func ExampleNewAmountFromProviderUnits() {
+	fmt.Println(money.NewAmountFromProviderUnits(money.Stripe, "ISK", 50000))
+	// Output: ISK 500 <nil>
+}
+
+func ExampleNewProvider() {
+	p, err := money.NewProvider("Acme", map[string]int{"HUF": 0})
+	if err != nil {
+		panic(err)
+	}
+	a := money.MustParseAmount("HUF", "1500.00")
+	fmt.Println(a.ProviderUnits(p))
+	fmt.Println(a.ProviderUnits(money.Stripe))
+	// Output:
+	// 1500 <nil>
+	// 150000 <nil>
+}
+
+func ExampleValidate() {
+	a := money.MustParseAmount("USD", "1.50")
+	b := money.MustParseAmount("USD", "1.505")
+	fmt.Println(money.Validate(a))
+	fmt.Println(money.Validate(b))
+	// Output:
+	// <nil>
+	// validating USD 1.505: invalid amount "1.505": more than 2 digits after the decimal point
+}
+
+func ExampleValidateValue() {
+	type PaymentRequest struct {
+		Amount money.NullAmount `validate:"money"`
+	}
+	req := PaymentRequest{
+		Amount: money.NullAmount{
+			Amount: money.MustParseAmount("JPY", "100.5"),
+			Valid:  true,
+		},
+	}
+	// A validation library passes the value of each field with the tag
+	// to the registered function.
+	field := reflect.ValueOf(req).Field(0)
+	fmt.Println(money.ValidateValue(field))
+	// Output: validating JPY 100.5: invalid amount "100.5": more than 0 digits after the decimal point
+}
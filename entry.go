@@ -0,0 +1,190 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// Side represents the side of a double-entry bookkeeping entry.
+type Side int8
+
+const (
+	// Debit increases asset and expense accounts, and decreases liability,
+	// equity, and income accounts.
+	Debit Side = iota
+	// Credit increases liability, equity, and income accounts, and decreases
+	// asset and expense accounts.
+	Credit
+)
+
+// String implements the [fmt.Stringer] interface and returns the name of
+// the side.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s Side) String() string {
+	switch s {
+	case Debit:
+		return "Debit"
+	case Credit:
+		return "Credit"
+	default:
+		return fmt.Sprintf("Side(%d)", int8(s))
+	}
+}
+
+// Opposite returns the other side, Credit for Debit and Debit for Credit.
+func (s Side) Opposite() Side {
+	if s == Debit {
+		return Credit
+	}
+	return Debit
+}
+
+// errUnbalanced is returned when debits and credits are not equal.
+var errUnbalanced = errors.New("entries are not balanced")
+
+// Entry represents a non-negative amount posted to the debit or credit side
+// of an account in double-entry bookkeeping.
+// An entry is immutable.
+// The zero value is a debit of "XXX 0".
+type Entry struct {
+	side   Side
+	amount Amount
+}
+
+// NewEntry returns an entry of the amount on the given side.
+// See also constructor [NewEntryFromSigned].
+//
+// NewEntry returns an error if:
+//   - the side is neither [Debit] nor [Credit];
+//   - the amount is negative.
+func NewEntry(side Side, a Amount) (Entry, error) {
+	switch {
+	case side != Debit && side != Credit:
+		return Entry{}, fmt.Errorf("creating entry: unknown side %v", side)
+	case a.IsNeg():
+		return Entry{}, fmt.Errorf("creating entry: %v is negative", a)
+	}
+	return Entry{side: side, amount: a}, nil
+}
+
+// MustNewEntry is like [NewEntry] but panics if the entry cannot be created.
+// This function simplifies safe initialization of global variables holding entries.
+func MustNewEntry(side Side, a Amount) Entry {
+	e, err := NewEntry(side, a)
+	if err != nil {
+		panic(fmt.Sprintf("NewEntry(%v, %v) failed: %v", side, a, err))
+	}
+	return e
+}
+
+// NewEntryFromSigned returns an entry for a signed amount: positive and zero
+// amounts become debits, and negative amounts become credits of their
+// absolute values.
+// This is the same convention as in [Amount.FormatTAccount].
+// See also method [Entry.Signed].
+func NewEntryFromSigned(a Amount) Entry {
+	if a.IsNeg() {
+		return Entry{side: Credit, amount: a.Abs()}
+	}
+	return Entry{side: Debit, amount: a}
+}
+
+// Side returns the side of the entry.
+func (e Entry) Side() Side {
+	return e.side
+}
+
+// Amount returns the non-negative amount of the entry.
+func (e Entry) Amount() Amount {
+	return e.amount
+}
+
+// Curr returns the currency of the entry.
+func (e Entry) Curr() Currency {
+	return e.amount.Curr()
+}
+
+// Signed returns the amount of the entry with debits positive and credits
+// negative.
+// See also constructor [NewEntryFromSigned].
+func (e Entry) Signed() Amount {
+	return e.SignedFor(Debit)
+}
+
+// SignedFor returns the change in the balance of an account with the given
+// normal balance: the amount is positive if the entry is on the normal side
+// of the account, and negative otherwise.
+// For example, a credit of "USD 10.00" increases a liability account,
+// whose normal balance is [Credit], by "USD 10.00", and decreases an asset
+// account, whose normal balance is [Debit], by the same amount.
+func (e Entry) SignedFor(normal Side) Amount {
+	if e.side != normal {
+		return e.amount.Neg()
+	}
+	return e.amount
+}
+
+// Reverse returns an entry with the same amount on the opposite side,
+// which cancels the original entry.
+func (e Entry) Reverse() Entry {
+	return Entry{side: e.side.Opposite(), amount: e.amount}
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the entry, for example "Debit USD 10.00".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (e Entry) String() string {
+	text := make([]byte, 0, 32)
+	text = append(text, e.side.String()...)
+	text = append(text, ' ')
+	text = e.amount.append(text)
+	return string(text)
+}
+
+// Balance returns the net balance of the entries in every currency, with
+// debits positive and credits negative.
+// Balanced entries have a zero balance in every currency, see [Basket.IsZero].
+// To compute the balance of a slice, use [slices.Values].
+// See also function [CheckBalanced].
+//
+// Balance returns an error if the integer part of any balance has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [slices.Values]: https://pkg.go.dev/slices#Values
+func Balance(entries iter.Seq[Entry]) (Basket, error) {
+	var b Basket
+	for e := range entries {
+		var err error
+		b, err = b.add([]Amount{e.Signed()}, false)
+		if err != nil {
+			return Basket{}, fmt.Errorf("computing balance: %w", err)
+		}
+	}
+	return b, nil
+}
+
+// CheckBalanced verifies that the debits of the entries are equal to their
+// credits in every currency, as required for a journal transaction in
+// double-entry bookkeeping.
+// To check a slice, use [slices.Values].
+// See also function [Balance].
+//
+// CheckBalanced returns an error if:
+//   - debits and credits in any currency are not equal;
+//   - the integer part of any balance has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [slices.Values]: https://pkg.go.dev/slices#Values
+func CheckBalanced(entries iter.Seq[Entry]) error {
+	b, err := Balance(entries)
+	if err != nil {
+		return err
+	}
+	if !b.IsZero() {
+		return fmt.Errorf("checking balance: net balance %v: %w", b, errUnbalanced)
+	}
+	return nil
+}
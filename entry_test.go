@@ -0,0 +1,176 @@
+package money
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSide_String(t *testing.T) {
+	tests := []struct {
+		s    Side
+		want string
+	}{
+		{Debit, "Debit"},
+		{Credit, "Credit"},
+		{Side(5), "Side(5)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("Side(%d).String() = %q, want %q", int8(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestSide_Opposite(t *testing.T) {
+	if got := Debit.Opposite(); got != Credit {
+		t.Errorf("Debit.Opposite() = %v, want %v", got, Credit)
+	}
+	if got := Credit.Opposite(); got != Debit {
+		t.Errorf("Credit.Opposite() = %v, want %v", got, Debit)
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			side   Side
+			amount string
+			want   string
+		}{
+			{Debit, "USD 10.00", "Debit USD 10.00"},
+			{Credit, "USD 10.00", "Credit USD 10.00"},
+			{Credit, "USD 0.00", "Credit USD 0.00"},
+		}
+		for _, tt := range tests {
+			a := mustParseSQLAmount(t, tt.amount)
+			got, err := NewEntry(tt.side, a)
+			if err != nil {
+				t.Errorf("NewEntry(%v, %q) failed: %v", tt.side, a, err)
+				continue
+			}
+			if got.Side() != tt.side || got.Amount() != a || got.Curr() != a.Curr() {
+				t.Errorf("NewEntry(%v, %q) = (%v, %q)", tt.side, a, got.Side(), got.Amount())
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewEntry(%v, %q).String() = %q, want %q", tt.side, a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			side   Side
+			amount string
+		}{
+			"negative amount": {Debit, "USD -1.00"},
+			"unknown side":    {Side(2), "USD 1.00"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := mustParseSQLAmount(t, tt.amount)
+				_, err := NewEntry(tt.side, a)
+				if err == nil {
+					t.Errorf("NewEntry(%v, %q) did not fail", tt.side, a)
+				}
+			})
+		}
+	})
+}
+
+func TestNewEntryFromSigned(t *testing.T) {
+	tests := []struct {
+		amount, want string
+	}{
+		{"USD 10.00", "Debit USD 10.00"},
+		{"USD -10.00", "Credit USD 10.00"},
+		{"USD 0.00", "Debit USD 0.00"},
+	}
+	for _, tt := range tests {
+		a := mustParseSQLAmount(t, tt.amount)
+		got := NewEntryFromSigned(a)
+		if got.String() != tt.want {
+			t.Errorf("NewEntryFromSigned(%q) = %q, want %q", a, got, tt.want)
+		}
+		if got.Signed() != a {
+			t.Errorf("NewEntryFromSigned(%q).Signed() = %q, want %q", a, got.Signed(), a)
+		}
+	}
+}
+
+func TestEntry_SignedFor(t *testing.T) {
+	tests := []struct {
+		side         Side
+		normal       Side
+		amount, want string
+	}{
+		{Debit, Debit, "USD 10.00", "USD 10.00"},
+		{Credit, Debit, "USD 10.00", "USD -10.00"},
+		{Credit, Credit, "USD 10.00", "USD 10.00"},
+		{Debit, Credit, "USD 10.00", "USD -10.00"},
+	}
+	for _, tt := range tests {
+		e := MustNewEntry(tt.side, mustParseSQLAmount(t, tt.amount))
+		want := mustParseSQLAmount(t, tt.want)
+		if got := e.SignedFor(tt.normal); got != want {
+			t.Errorf("%v.SignedFor(%v) = %q, want %q", e, tt.normal, got, want)
+		}
+	}
+}
+
+func TestEntry_Reverse(t *testing.T) {
+	e := MustNewEntry(Debit, MustParseAmount("USD", "10"))
+	got := e.Reverse()
+	if got.Side() != Credit || got.Amount() != e.Amount() {
+		t.Errorf("%v.Reverse() = %v", e, got)
+	}
+	if err := CheckBalanced(slices.Values([]Entry{e, got})); err != nil {
+		t.Errorf("CheckBalanced([%v, %v]) failed: %v", e, got, err)
+	}
+}
+
+func TestBalance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		usd := func(side Side, s string) Entry { return MustNewEntry(side, MustParseAmount("USD", s)) }
+		eur := func(side Side, s string) Entry { return MustNewEntry(side, MustParseAmount("EUR", s)) }
+		tests := []struct {
+			entries      []Entry
+			want         string
+			wantBalanced bool
+		}{
+			{nil, "[]", true},
+			{[]Entry{usd(Debit, "10"), usd(Credit, "10")}, "[USD 0.00]", true},
+			{[]Entry{usd(Debit, "10"), usd(Credit, "7.50"), usd(Credit, "2.5")}, "[USD 0.00]", true},
+			{[]Entry{usd(Debit, "10"), usd(Credit, "10"), eur(Credit, "5"), eur(Debit, "5")}, "[EUR 0.00, USD 0.00]", true},
+			{[]Entry{usd(Debit, "10"), usd(Credit, "9.99")}, "[USD 0.01]", false},
+			{[]Entry{usd(Debit, "10"), eur(Credit, "10")}, "[EUR -10.00, USD 10.00]", false},
+		}
+		for _, tt := range tests {
+			got, err := Balance(slices.Values(tt.entries))
+			if err != nil {
+				t.Errorf("Balance(%v) failed: %v", tt.entries, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("Balance(%v) = %v, want %v", tt.entries, got, tt.want)
+			}
+			err = CheckBalanced(slices.Values(tt.entries))
+			if tt.wantBalanced && err != nil {
+				t.Errorf("CheckBalanced(%v) failed: %v", tt.entries, err)
+			}
+			if !tt.wantBalanced && err == nil {
+				t.Errorf("CheckBalanced(%v) did not fail", tt.entries)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		large := MustNewEntry(Debit, MustParseAmount("USD", "99999999999999999"))
+		entries := []Entry{large, large}
+		if _, err := Balance(slices.Values(entries)); err == nil {
+			t.Errorf("Balance(%v) did not fail", entries)
+		}
+		if err := CheckBalanced(slices.Values(entries)); err == nil {
+			t.Errorf("CheckBalanced(%v) did not fail", entries)
+		}
+	})
+}
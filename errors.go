@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Errors returned by this package can be inspected with [errors.Is] and
+// [errors.As], for example to map them to distinct HTTP status codes.
+// Error messages are intended for humans and may change between versions.
+var (
+	// ErrCurrencyMismatch is returned when an operation combines amounts or
+	// exchange rates denominated in incompatible currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+
+	// ErrOverflow is returned when the integer part of a result has more than
+	// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+	ErrOverflow = errors.New("amount overflow")
+)
+
+// UnknownCurrencyError is returned when a currency code or symbol does not
+// denote any known currency.
+type UnknownCurrencyError struct {
+	Code string // Code or symbol that could not be resolved
+}
+
+// Error implements the [error] interface.
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("unknown currency %q", e.Code)
+}
+
+// InvalidAmountError is returned when a string cannot be converted to
+// an amount, for example because it contains invalid characters or has
+// too many digits.
+type InvalidAmountError struct {
+	Input string // String that could not be converted
+	Err   error  // Underlying error, if any
+}
+
+// Error implements the [error] interface.
+func (e *InvalidAmountError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("invalid amount %q", e.Input)
+	}
+	return fmt.Sprintf("invalid amount %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *InvalidAmountError) Unwrap() error {
+	return e.Err
+}
+
+// errDecimalOverflow is the error that the decimal package reports when
+// a result does not fit into a decimal.
+// The decimal package does not export it, so it is obtained from
+// an operation that is known to overflow.
+var errDecimalOverflow = func() error {
+	_, err := decimal.MustParse("9999999999999999999").Add(decimal.One)
+	for errors.Unwrap(err) != nil {
+		err = errors.Unwrap(err)
+	}
+	return err
+}()
+
+// overflowError is a decimal overflow error that also matches [ErrOverflow].
+type overflowError struct {
+	err error
+}
+
+func (e overflowError) Error() string {
+	return e.err.Error()
+}
+
+func (e overflowError) Unwrap() error {
+	return e.err
+}
+
+func (e overflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// decimalErr converts an error returned by the decimal package so that
+// overflows match [ErrOverflow].
+// Other errors are returned as is.
+func decimalErr(err error) error {
+	if errDecimalOverflow != nil && errors.Is(err, errDecimalOverflow) {
+		return overflowError{err: err}
+	}
+	return err
+}
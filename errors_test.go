@@ -0,0 +1,142 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestErrCurrencyMismatch(t *testing.T) {
+	a := MustParseAmount("USD", "1.00")
+	b := MustParseAmount("EUR", "1.00")
+	r := MustNewExchRate("EUR", "USD", 1, 0)
+	tests := map[string]func() error{
+		"Add":    func() error { _, err := a.Add(b); return err },
+		"Sub":    func() error { _, err := a.Sub(b); return err },
+		"AddMul": func() error { _, err := a.AddMul(b, decimal.One); return err },
+		"Cmp":    func() error { _, err := a.Cmp(b); return err },
+		"Conv":   func() error { _, err := r.Conv(MustParseAmount("GBP", "1.00")); return err },
+		"Parse":  func() error { _, err := MustNewFormatter("en").Parse("$1.00", EUR); return err },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := f()
+			if !errors.Is(err, ErrCurrencyMismatch) {
+				t.Errorf("errors.Is(%v, ErrCurrencyMismatch) = false, want true", err)
+			}
+			if errors.Is(err, ErrOverflow) {
+				t.Errorf("errors.Is(%v, ErrOverflow) = true, want false", err)
+			}
+		})
+	}
+}
+
+func TestErrOverflow(t *testing.T) {
+	a := MustParseAmount("USD", "99999999999999999")
+	r := MustNewExchRate("EUR", "USD", 1000, 0)
+	tests := map[string]func() error{
+		"Add":      func() error { _, err := a.Add(a); return err },
+		"Sub":      func() error { _, err := a.Sub(a.Neg()); return err },
+		"Mul":      func() error { _, err := a.Mul(decimal.Ten); return err },
+		"MulRound": func() error { _, err := a.MulRound(decimal.Ten); return err },
+		"AddMul":   func() error { _, err := a.AddMul(a, decimal.Ten); return err },
+		"Quo":      func() error { _, err := a.Quo(decimal.MustParse("0.1")); return err },
+		"Conv":     func() error { _, err := r.Conv(MustParseAmount("EUR", "999999999999999")); return err },
+		"Sum":      func() error { _, err := Sum(func(yield func(Amount) bool) { _ = yield(a) && yield(a) }); return err },
+		"Basket":   func() error { _, err := NewBasket(a, a); return err },
+		"ExchRate": func() error { _, err := NewExchRate("EUR", "USD", 1234567890123456789, 0); return err },
+		"RoundToUnit": func() error {
+			_, err := MustParseAmount("JPY", "9999999999999999999").RoundToUnit(decimal.Ten, Ceiling)
+			return err
+		},
+		"ParseAmount":          func() error { _, err := ParseAmount("USD", "123456789012345678901"); return err },
+		"NewAmountFromFloat64": func() error { _, err := NewAmountFromFloat64("USD", 1e20); return err },
+		"ParseExchRate":        func() error { _, err := ParseExchRate("EUR", "USD", "123456789012345678901"); return err },
+		"Formatter.Parse": func() error {
+			_, err := MustNewFormatter("en").Parse("$123,456,789,012,345,678,901", XXX)
+			return err
+		},
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := f()
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("errors.Is(%v, ErrOverflow) = false, want true", err)
+			}
+		})
+	}
+
+	// Other decimal errors must not match
+	_, err := a.Quo(decimal.Zero)
+	if err == nil || errors.Is(err, ErrOverflow) {
+		t.Errorf("errors.Is(%v, ErrOverflow) = true, want false", err)
+	}
+}
+
+func TestErrDecimalOverflow(t *testing.T) {
+	// Matching of decimal overflows with ErrOverflow depends on the error
+	// obtained from the decimal package at initialization.
+	if errDecimalOverflow == nil {
+		t.Fatal("errDecimalOverflow = nil, want decimal overflow error")
+	}
+	_, err := decimal.MustParse("9999999999999999999").Add(decimal.One)
+	if !errors.Is(decimalErr(err), ErrOverflow) {
+		t.Errorf("errors.Is(decimalErr(%v), ErrOverflow) = false, want true", err)
+	}
+}
+
+func TestUnknownCurrencyError(t *testing.T) {
+	tests := map[string]struct {
+		f    func() error
+		want string
+	}{
+		"ParseCurr":              {func() error { _, err := ParseCurr("ABC"); return err }, "ABC"},
+		"CurrByNum":              {func() error { _, err := CurrByNum(1); return err }, "001"},
+		"ParseAmount":            {func() error { _, err := ParseAmount("US", "1"); return err }, "US"},
+		"NewAmount":              {func() error { _, err := NewAmount("ABC", 1, 0); return err }, "ABC"},
+		"RegisterHistoricalCurr": {func() error { _, err := RegisterHistoricalCurr("USD"); return err }, "USD"},
+		"ParseSWIFTAmount":       {func() error { _, err := ParseSWIFTAmount("usd1,"); return err }, "usd"},
+		"Formatter.Parse":        {func() error { _, err := MustNewFormatter("en").Parse("1.00 ABC", XXX); return err }, "ABC"},
+		"UnmarshalJSON":          {func() error { var a Amount; return json.Unmarshal([]byte(`"ABC 1.00"`), &a) }, "ABC"},
+		"SetCurrScale":           {func() error { return SetCurrScale(Currency(255), 2) }, "255"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.f()
+			var e *UnknownCurrencyError
+			if !errors.As(err, &e) {
+				t.Fatalf("errors.As(%v, *UnknownCurrencyError) = false, want true", err)
+			}
+			if e.Code != tt.want {
+				t.Errorf("errors.As(%v, *UnknownCurrencyError).Code = %q, want %q", err, e.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidAmountError(t *testing.T) {
+	tests := map[string]struct {
+		f    func() error
+		want string
+	}{
+		"ParseAmount 1":    {func() error { _, err := ParseAmount("USD", "1.0a"); return err }, "1.0a"},
+		"ParseAmount 2":    {func() error { _, err := ParseAmount("USD", "999999999999999999"); return err }, "999999999999999999"},
+		"ParseSWIFTAmount": {func() error { _, err := ParseSWIFTAmount("USD1.00"); return err }, "1.00"},
+		"Formatter.Parse":  {func() error { _, err := MustNewFormatter("en").Parse("$1.2.3", XXX); return err }, "1.2.3"},
+		"UnmarshalText":    {func() error { var a Amount; return a.UnmarshalText([]byte("USD x")) }, "x"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.f()
+			var e *InvalidAmountError
+			if !errors.As(err, &e) {
+				t.Fatalf("errors.As(%v, *InvalidAmountError) = false, want true", err)
+			}
+			if e.Input != tt.want {
+				t.Errorf("errors.As(%v, *InvalidAmountError).Input = %q, want %q", err, e.Input, tt.want)
+			}
+		})
+	}
+}
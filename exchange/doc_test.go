@@ -0,0 +1,44 @@
+package exchange_test
+
+import (
+	"fmt"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/exchange"
+)
+
+func ExampleConv() {
+	rates := exchange.NewTable(
+		money.MustParseExchRate("EUR", "USD", "1.2345"),
+	)
+	a := money.MustParseAmount("EUR", "10.00")
+	fmt.Println(exchange.Conv(a, money.USD, rates, money.HalfEven))
+	fmt.Println(exchange.Conv(a, money.USD, rates, money.HalfUp))
+	// Output:
+	// USD 12.34 <nil>
+	// USD 12.35 <nil>
+}
+
+func ExampleTable_ExchRate() {
+	rates := exchange.NewTable(
+		money.MustParseExchRate("USD", "JPY", "160"),
+	)
+	fmt.Println(rates.ExchRate(money.USD, money.JPY))
+	fmt.Println(rates.ExchRate(money.JPY, money.USD))
+	// Output:
+	// USD/JPY 160 <nil>
+	// JPY/USD 0.00625 <nil>
+}
+
+func ExamplePivot_ExchRate() {
+	rates := exchange.NewTable(
+		money.MustParseExchRate("EUR", "USD", "1.10"),
+		money.MustParseExchRate("USD", "JPY", "150"),
+	)
+	p, _ := exchange.NewPivot(rates, "USD")
+	fmt.Println(p.ExchRate(money.EUR, money.JPY))
+	fmt.Println(p.ExchRate(money.JPY, money.EUR))
+	// Output:
+	// EUR/JPY 165.00 <nil>
+	// JPY/EUR 0.0060606060606060606 <nil>
+}
@@ -0,0 +1,154 @@
+/*
+Package exchange implements sources of exchange rates and conversions between
+amounts in different currencies.
+
+Sources of exchange rates implement the [money.Rater] interface:
+  - [Table] is an in-memory table of exchange rates.
+  - [Pivot] derives cross rates from rates against a pivot currency.
+
+[Conv] converts amounts using a [money.Rater] and rounds the result to the
+scale of the target currency using an explicitly specified [money.RoundingMode].
+*/
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+// Conv converts amount a to the given currency using the exchange rate
+// provided by rater r, and rounds the result to the scale of the currency
+// using the specified rounding mode.
+// If the amount is already denominated in the given currency, it is only rounded.
+// See also method [money.ExchangeRate.ConvRound].
+//
+// Conv returns an error if:
+//   - the rater fails to provide a rate or provides a rate for a different
+//     currency pair;
+//   - the conversion fails.
+func Conv(a money.Amount, curr money.Currency, r money.Rater, mode money.RoundingMode) (money.Amount, error) {
+	b, err := conv(a, curr, r, mode)
+	if err != nil {
+		return money.Amount{}, fmt.Errorf("converting [%v] to %v: %w", a, curr, err)
+	}
+	return b, nil
+}
+
+func conv(a money.Amount, n money.Currency, r money.Rater, mode money.RoundingMode) (money.Amount, error) {
+	m := a.Curr()
+	if m == n {
+		return a.RoundWith(n.Scale(), mode), nil
+	}
+	q, err := exchRate(r, m, n)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	return q.ConvRound(a, mode)
+}
+
+// exchRate returns the m/n rate provided by rater r, ensuring that the rater
+// did not return a rate for a different currency pair.
+func exchRate(r money.Rater, m, n money.Currency) (money.ExchangeRate, error) {
+	q, err := r.ExchRate(m, n)
+	if err != nil {
+		return money.ExchangeRate{}, fmt.Errorf("getting %v/%v rate: %w", m, n, err)
+	}
+	if q.Base() != m || q.Quote() != n {
+		return money.ExchangeRate{}, fmt.Errorf("getting %v/%v rate: got %v/%v rate", m, n, q.Base(), q.Quote())
+	}
+	return q, nil
+}
+
+// Table is an in-memory table of exchange rates.
+// Table is designed to be safe for concurrent use by multiple goroutines.
+type Table struct {
+	rates map[[2]money.Currency]money.ExchangeRate
+}
+
+// NewTable returns a table containing the given exchange rates.
+// If several rates are given for the same currency pair, the last one is used.
+func NewTable(rates ...money.ExchangeRate) Table {
+	t := Table{rates: make(map[[2]money.Currency]money.ExchangeRate, len(rates))}
+	for _, r := range rates {
+		t.rates[[2]money.Currency{r.Base(), r.Quote()}] = r
+	}
+	return t
+}
+
+// ExchRate implements the [money.Rater] interface.
+// If the table contains only the rate for the reverse currency pair,
+// the (possibly rounded) inverse of that rate is returned.
+// The rate between identical currencies is always 1.
+//
+// ExchRate returns an error if the table contains neither the rate for
+// the currency pair nor the rate for the reverse pair.
+func (t Table) ExchRate(base, quote money.Currency) (money.ExchangeRate, error) {
+	if base == quote {
+		return money.NewExchRateFromDecimal(base, quote, decimal.One)
+	}
+	if r, ok := t.rates[[2]money.Currency{base, quote}]; ok {
+		return r, nil
+	}
+	if r, ok := t.rates[[2]money.Currency{quote, base}]; ok {
+		d, err := decimal.One.Quo(r.Decimal())
+		if err != nil {
+			return money.ExchangeRate{}, fmt.Errorf("inverting %v: %w", r, err)
+		}
+		return money.NewExchRateFromDecimal(base, quote, d)
+	}
+	return money.ExchangeRate{}, fmt.Errorf("%v/%v rate not found", base, quote)
+}
+
+// Pivot is a source of exchange rates that derives cross rates from rates
+// against a pivot currency.
+// For example, with USD as the pivot currency, the EUR/JPY rate is
+// computed as the product of the EUR/USD and USD/JPY rates.
+// Pivot is designed to be safe for concurrent use by multiple goroutines,
+// provided that the underlying rater is.
+type Pivot struct {
+	r     money.Rater
+	pivot money.Currency
+}
+
+// NewPivot returns a source of exchange rates that derives cross rates
+// from the rates against the pivot currency provided by rater r.
+//
+// NewPivot returns an error if the currency code is not valid.
+func NewPivot(r money.Rater, pivot string) (Pivot, error) {
+	c, err := money.ParseCurr(pivot)
+	if err != nil {
+		return Pivot{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	return Pivot{r: r, pivot: c}, nil
+}
+
+// ExchRate implements the [money.Rater] interface.
+// If either currency is the pivot currency, the rate is obtained from
+// the underlying rater directly.
+// Otherwise, the (possibly rounded) cross rate is computed through
+// the pivot currency.
+//
+// ExchRate returns an error if:
+//   - the underlying rater fails to provide any of the rates or provides
+//     a rate for a different currency pair;
+//   - the cross rate is out of range.
+func (p Pivot) ExchRate(base, quote money.Currency) (money.ExchangeRate, error) {
+	if base == quote || base == p.pivot || quote == p.pivot {
+		return exchRate(p.r, base, quote)
+	}
+	q, err := exchRate(p.r, base, p.pivot)
+	if err != nil {
+		return money.ExchangeRate{}, err
+	}
+	r, err := exchRate(p.r, p.pivot, quote)
+	if err != nil {
+		return money.ExchangeRate{}, err
+	}
+	d, err := q.Decimal().Mul(r.Decimal())
+	if err != nil {
+		return money.ExchangeRate{}, fmt.Errorf("computing [%v * %v]: %w", q, r, err)
+	}
+	return money.NewExchRateFromDecimal(base, quote, d)
+}
@@ -0,0 +1,192 @@
+package exchange
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lunafinancialgroup/money"
+)
+
+// wrongPair is a rater that always returns the EUR/USD rate.
+type wrongPair struct{}
+
+func (wrongPair) ExchRate(_, _ money.Currency) (money.ExchangeRate, error) {
+	return money.MustParseExchRate("EUR", "USD", "1.1"), nil
+}
+
+// failing is a rater that always fails.
+type failing struct{}
+
+func (failing) ExchRate(base, quote money.Currency) (money.ExchangeRate, error) {
+	return money.ExchangeRate{}, fmt.Errorf("%v/%v rate is not available", base, quote)
+}
+
+func TestConv(t *testing.T) {
+	rates := NewTable(
+		money.MustParseExchRate("EUR", "USD", "1.2345"),
+		money.MustParseExchRate("USD", "JPY", "150.5"),
+	)
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, d, curr string
+			mode       money.RoundingMode
+			want       string
+		}{
+			{"EUR", "10.00", "USD", money.HalfEven, "12.34"},
+			{"EUR", "10.00", "USD", money.HalfUp, "12.35"},
+			{"EUR", "10.00", "USD", money.Floor, "12.34"},
+			{"EUR", "-10.00", "USD", money.Floor, "-12.35"},
+			{"USD", "12.35", "EUR", money.HalfEven, "10.00"},
+			{"USD", "1.01", "JPY", money.HalfEven, "152"},
+			{"USD", "1.01", "JPY", money.Truncate, "152"},
+			{"USD", "1.009", "USD", money.HalfUp, "1.01"},
+			{"USD", "1.009", "USD", money.Truncate, "1.00"},
+		}
+		for _, tt := range tests {
+			a := money.MustParseAmount(tt.m, tt.d)
+			n := money.MustParseCurr(tt.curr)
+			got, err := Conv(a, n, rates, tt.mode)
+			if err != nil {
+				t.Errorf("Conv(%q, %v, %v) failed: %v", a, n, tt.mode, err)
+				continue
+			}
+			want := money.MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("Conv(%q, %v, %v) = %q, want %q", a, n, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, d, curr string
+			r          money.Rater
+		}{
+			"missing rate": {"EUR", "10.00", "GBP", rates},
+			"failing":      {"EUR", "10.00", "USD", failing{}},
+			"wrong pair":   {"GBP", "10.00", "USD", wrongPair{}},
+			"overflow":     {"USD", "99999999999999999", "JPY", rates},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := money.MustParseAmount(tt.m, tt.d)
+				n := money.MustParseCurr(tt.curr)
+				_, err := Conv(a, n, tt.r, money.HalfEven)
+				if err == nil {
+					t.Errorf("Conv(%q, %v) did not fail", a, n)
+				}
+			})
+		}
+	})
+}
+
+func TestTable_ExchRate(t *testing.T) {
+	rates := NewTable(
+		money.MustParseExchRate("EUR", "USD", "1.10"),
+		money.MustParseExchRate("USD", "JPY", "150"),
+		money.MustParseExchRate("USD", "JPY", "160"),
+	)
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			base, quote, want string
+		}{
+			{"EUR", "USD", "1.10"},
+			{"USD", "EUR", "0.9090909090909090909"},
+			{"USD", "JPY", "160"},
+			{"JPY", "USD", "0.00625"},
+			{"GBP", "GBP", "1.00"},
+		}
+		for _, tt := range tests {
+			m, n := money.MustParseCurr(tt.base), money.MustParseCurr(tt.quote)
+			got, err := rates.ExchRate(m, n)
+			if err != nil {
+				t.Errorf("ExchRate(%v, %v) failed: %v", m, n, err)
+				continue
+			}
+			want := money.MustParseExchRate(tt.base, tt.quote, tt.want)
+			if got != want {
+				t.Errorf("ExchRate(%v, %v) = %q, want %q", m, n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			base, quote string
+		}{
+			{"EUR", "JPY"},
+			{"GBP", "USD"},
+		}
+		for _, tt := range tests {
+			m, n := money.MustParseCurr(tt.base), money.MustParseCurr(tt.quote)
+			_, err := rates.ExchRate(m, n)
+			if err == nil {
+				t.Errorf("ExchRate(%v, %v) did not fail", m, n)
+			}
+		}
+	})
+}
+
+func TestNewPivot(t *testing.T) {
+	_, err := NewPivot(NewTable(), "UUU")
+	if err == nil {
+		t.Errorf("NewPivot(%q) did not fail", "UUU")
+	}
+}
+
+func TestPivot_ExchRate(t *testing.T) {
+	rates := NewTable(
+		money.MustParseExchRate("EUR", "USD", "1.10"),
+		money.MustParseExchRate("USD", "JPY", "150"),
+		money.MustParseExchRate("GBP", "USD", "1.25"),
+	)
+	p, err := NewPivot(rates, "USD")
+	if err != nil {
+		t.Fatalf("NewPivot(%q) failed: %v", "USD", err)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			base, quote, want string
+		}{
+			{"EUR", "USD", "1.10"},
+			{"USD", "JPY", "150"},
+			{"EUR", "JPY", "165.00"},
+			{"EUR", "GBP", "0.8800"},
+			{"GBP", "EUR", "1.136363636363636364"},
+			{"JPY", "GBP", "0.0053333333333333334"},
+			{"EUR", "EUR", "1.00"},
+		}
+		for _, tt := range tests {
+			m, n := money.MustParseCurr(tt.base), money.MustParseCurr(tt.quote)
+			got, err := p.ExchRate(m, n)
+			if err != nil {
+				t.Errorf("ExchRate(%v, %v) failed: %v", m, n, err)
+				continue
+			}
+			want := money.MustParseExchRate(tt.base, tt.quote, tt.want)
+			if got != want {
+				t.Errorf("ExchRate(%v, %v) = %q, want %q", m, n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			base, quote string
+		}{
+			{"EUR", "CHF"},
+			{"CHF", "EUR"},
+			{"CHF", "USD"},
+		}
+		for _, tt := range tests {
+			m, n := money.MustParseCurr(tt.base), money.MustParseCurr(tt.quote)
+			_, err := p.ExchRate(m, n)
+			if err == nil {
+				t.Errorf("ExchRate(%v, %v) did not fail", m, n)
+			}
+		}
+	})
+}
@@ -8,8 +8,6 @@ import (
 	"github.com/govalues/decimal"
 )
 
-var errRateOverflow = fmt.Errorf("rate overflow")
-
 // ExchangeRate represents an exchange rate between two currencies.
 // The zero value corresponds to an exchange rate of "XXX/XXX 0", where [XXX] indicates
 // an unknown currency.
@@ -40,7 +38,7 @@ func newExchRateSafe(m, n Currency, d decimal.Decimal) (ExchangeRate, error) {
 	if d.Scale() < n.Scale() {
 		d = d.Pad(n.Scale())
 		if d.Scale() < n.Scale() {
-			return ExchangeRate{}, fmt.Errorf("padding exchange rate: %w", errRateOverflow)
+			return ExchangeRate{}, fmt.Errorf("padding exchange rate: %w", ErrOverflow)
 		}
 	}
 	return newExchRateUnsafe(m, n, d), nil
@@ -260,7 +258,7 @@ func ParseExchRate(base, quote, rate string) (ExchangeRate, error) {
 	// Decimal
 	d, err := decimal.ParseExact(rate, n.Scale())
 	if err != nil {
-		return ExchangeRate{}, fmt.Errorf("parsing exchange rate: %w", err)
+		return ExchangeRate{}, fmt.Errorf("parsing exchange rate: %w", decimalErr(err))
 	}
 	// Rate
 	r, err := newExchRateSafe(m, n, d)
@@ -581,25 +579,37 @@ func (r ExchangeRate) Conv(b Amount) (Amount, error) {
 
 func (r ExchangeRate) conv(b Amount) (Amount, error) {
 	if !r.CanConv(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, ErrCurrencyMismatch
 	}
 	m, n, d, e := r.Base(), r.Quote(), r.Decimal(), b.Decimal()
 	if m == b.Curr() {
 		// Direct conversion
 		e, err := e.MulExact(d, n.Scale())
 		if err != nil {
-			return Amount{}, fmt.Errorf("[%v -> %v]: %w", m, n, err)
+			return Amount{}, fmt.Errorf("[%v -> %v]: %w", m, n, decimalErr(err))
 		}
 		return newAmountSafe(n, e)
 	}
 	// Reverse conversion
 	e, err := e.QuoExact(d, m.Scale())
 	if err != nil {
-		return Amount{}, fmt.Errorf("[%v <- %v]: %w", m, n, err)
+		return Amount{}, fmt.Errorf("[%v <- %v]: %w", m, n, decimalErr(err))
 	}
 	return newAmountSafe(m, e)
 }
 
+// ConvRound is like [ExchangeRate.Conv] but rounds the result to the scale
+// of its currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+func (r ExchangeRate) ConvRound(b Amount, mode ...RoundingMode) (Amount, error) {
+	m := roundingMode(mode)
+	q, err := r.conv(b)
+	if err != nil {
+		return Amount{}, fmt.Errorf("converting [%v] rounded %v: %w", b, m, err)
+	}
+	return q.RoundWith(q.Curr().Scale(), m), nil
+}
+
 // Mul returns an exchange rate with the same base and quote currencies,
 // but with the rate multiplied by a factor.
 //
@@ -621,7 +631,7 @@ func (r ExchangeRate) mul(e decimal.Decimal) (ExchangeRate, error) {
 	m, n, d := r.Base(), r.Quote(), r.Decimal()
 	d, err := d.MulExact(e, n.Scale())
 	if err != nil {
-		return ExchangeRate{}, err
+		return ExchangeRate{}, decimalErr(err)
 	}
 	return newExchRateSafe(m, n, d)
 }
@@ -639,7 +649,7 @@ func (r ExchangeRate) inv() (ExchangeRate, error) {
 	m, n, d, e := r.Base(), r.Quote(), r.Decimal(), decimal.One
 	d, err := e.QuoExact(d, m.Scale())
 	if err != nil {
-		return ExchangeRate{}, err
+		return ExchangeRate{}, decimalErr(err)
 	}
 	return newExchRateSafe(n, m, d)
 }
@@ -765,3 +775,61 @@ func (r ExchangeRate) SameScale(q ExchangeRate) bool {
 	d, e := r.Decimal(), q.Decimal()
 	return d.SameScale(e)
 }
+
+// Rater is the interface implemented by sources of exchange rates,
+// such as in-memory rate tables or clients of market data providers.
+type Rater interface {
+	// ExchRate returns the exchange rate for converting amounts
+	// from the base currency to the quote currency.
+	ExchRate(base, quote Currency) (ExchangeRate, error)
+}
+
+// ConvRoundTrip converts amount a to the given currency and then back to the
+// currency of the amount, using exchange rates provided by rater r for both directions.
+// It returns the converted amount and the amount obtained by converting it back.
+// This function is useful for validating the consistency of exchange rates,
+// since the difference between a and back is the loss incurred on a round trip.
+// See also method [ExchangeRate.Conv].
+//
+// ConvRoundTrip returns an error if:
+//   - the currency code is not valid;
+//   - the rater fails to provide a rate or provides a rate for a different
+//     currency pair;
+//   - either of the conversions fails.
+func ConvRoundTrip(a Amount, curr string, r Rater) (conv, back Amount, err error) {
+	conv, back, err = convRoundTrip(a, curr, r)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("converting [%v] to %v and back: %w", a, curr, err)
+	}
+	return conv, back, nil
+}
+
+func convRoundTrip(a Amount, curr string, r Rater) (conv, back Amount, err error) {
+	m := a.Curr()
+	n, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	conv, err = convWithRater(a, n, r)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	back, err = convWithRater(conv, m, r)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return conv, back, nil
+}
+
+// convWithRater converts amount a to currency n using the rate provided by rater r.
+func convWithRater(a Amount, n Currency, r Rater) (Amount, error) {
+	m := a.Curr()
+	q, err := r.ExchRate(m, n)
+	if err != nil {
+		return Amount{}, fmt.Errorf("getting %v/%v rate: %w", m, n, err)
+	}
+	if q.Base() != m || q.Quote() != n {
+		return Amount{}, fmt.Errorf("getting %v/%v rate: got %v/%v rate", m, n, q.Base(), q.Quote())
+	}
+	return q.Conv(a)
+}
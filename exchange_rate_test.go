@@ -474,6 +474,49 @@ func TestExchangeRate_Conv(t *testing.T) {
 	})
 }
 
+func TestExchangeRate_ConvRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, n, r, c, d string
+			mode          RoundingMode
+			want          string
+		}{
+			{"EUR", "USD", "1.0995", "EUR", "100.00", HalfUp, "109.95"},
+			{"EUR", "USD", "1.0995", "EUR", "0.05", HalfEven, "0.05"},
+			{"EUR", "USD", "1.1", "EUR", "0.05", HalfEven, "0.06"},
+			{"EUR", "USD", "1.1", "EUR", "0.15", HalfEven, "0.16"},
+			{"EUR", "USD", "1.1", "EUR", "0.15", HalfUp, "0.17"},
+			{"EUR", "USD", "1.1", "EUR", "0.15", HalfDown, "0.16"},
+			{"EUR", "USD", "1.1", "EUR", "0.15", Floor, "0.16"},
+			{"EUR", "USD", "1.0995", "USD", "100.00", HalfUp, "90.95"},
+			{"EUR", "USD", "1.0995", "USD", "100.00", Ceiling, "90.96"},
+			{"USD", "JPY", "150.55", "USD", "1.00", HalfUp, "151"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.m, tt.n, tt.r)
+			a := MustParseAmount(tt.c, tt.d)
+			got, err := r.ConvRound(a, tt.mode)
+			if err != nil {
+				t.Errorf("%q.ConvRound(%q, %v) failed: %v", r, a, tt.mode, err)
+				continue
+			}
+			want := MustParseAmount(got.Curr().Code(), tt.want)
+			if got != want {
+				t.Errorf("%q.ConvRound(%q, %v) = %q, want %q", r, a, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := MustParseExchRate("USD", "EUR", "1.2000")
+		a := MustParseAmount("JPY", "100")
+		_, err := r.ConvRound(a, HalfUp)
+		if err == nil {
+			t.Errorf("%q.ConvRound(%q) did not fail", r, a)
+		}
+	})
+}
+
 func TestExchangeRate_Format(t *testing.T) {
 	tests := []struct {
 		m, n, d, format, want string
@@ -853,3 +896,90 @@ func TestExchangeRate_Quantize(t *testing.T) {
 		}
 	})
 }
+
+// rateTable is a simple implementation of the Rater interface.
+type rateTable map[[2]Currency]ExchangeRate
+
+func (t rateTable) ExchRate(base, quote Currency) (ExchangeRate, error) {
+	r, ok := t[[2]Currency{base, quote}]
+	if !ok {
+		return ExchangeRate{}, fmt.Errorf("rate not found")
+	}
+	return r, nil
+}
+
+func newRateTable(rates ...ExchangeRate) rateTable {
+	t := rateTable{}
+	for _, r := range rates {
+		t[[2]Currency{r.Base(), r.Quote()}] = r
+	}
+	return t
+}
+
+func TestConvRoundTrip(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		rates := newRateTable(
+			MustParseExchRate("USD", "EUR", "0.8"),
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150.00"),
+			MustParseExchRate("JPY", "USD", "0.006666666666666667"),
+		)
+		tests := []struct {
+			m, d, n, wantConv, wantBack string
+		}{
+			{"USD", "100.00", "EUR", "80.0000", "100.000000"},
+			{"EUR", "5.67", "USD", "7.0875", "5.670000"},
+			{"USD", "100.00", "JPY", "15000.0000", "100.0000000000000050"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.d)
+			gotConv, gotBack, err := ConvRoundTrip(a, tt.n, rates)
+			if err != nil {
+				t.Errorf("ConvRoundTrip(%q, %q) failed: %v", a, tt.n, err)
+				continue
+			}
+			wantConv := MustParseAmount(tt.n, tt.wantConv)
+			wantBack := MustParseAmount(tt.m, tt.wantBack)
+			if gotConv != wantConv || gotBack != wantBack {
+				t.Errorf("ConvRoundTrip(%q, %q) = %q, %q, want %q, %q", a, tt.n, gotConv, gotBack, wantConv, wantBack)
+			}
+			// Round trip loss
+			loss, err := gotBack.SubAbs(a)
+			if err != nil {
+				t.Errorf("%q.SubAbs(%q) failed: %v", gotBack, a, err)
+				continue
+			}
+			if !loss.RoundToCurr().IsZero() {
+				t.Errorf("ConvRoundTrip(%q, %q) lost %q", a, tt.n, loss)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		rates := newRateTable(
+			MustParseExchRate("USD", "EUR", "0.8"),
+			MustParseExchRate("EUR", "GBP", "0.85"),
+			MustParseExchRate("GBP", "USD", "1.25"),
+			MustParseExchRate("USD", "CHF", "0.9"),
+		)
+		rates[[2]Currency{CHF, USD}] = MustParseExchRate("CHF", "EUR", "1.05")
+		tests := map[string]struct {
+			m, d, n string
+		}{
+			"currency 1": {"USD", "1.00", "UUU"},
+			"missing 1":  {"USD", "1.00", "JPY"},
+			"missing 2":  {"EUR", "1.00", "GBP"},
+			"pair 1":     {"USD", "1.00", "CHF"},
+			"overflow 1": {"GBP", "99999999999999999.99", "USD"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.d)
+				_, _, err := ConvRoundTrip(a, tt.n, rates)
+				if err == nil {
+					t.Errorf("ConvRoundTrip(%q, %q) did not fail", a, tt.n)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package finance_test
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/finance"
+)
+
+func ExampleSimpleInterest() {
+	p := money.MustParseAmount("USD", "1000.00")
+	r := decimal.MustParse("0.05")
+	fmt.Println(finance.SimpleInterest(p, r, 3, money.HalfEven))
+	// Output:
+	// USD 150.00 <nil>
+}
+
+func ExampleCompoundInterest() {
+	p := money.MustParseAmount("USD", "1000.00")
+	r := decimal.MustParse("0.05")
+	fmt.Println(finance.CompoundInterest(p, r, 3, money.HalfEven))
+	fmt.Println(finance.CompoundInterest(p, r, 3, money.HalfUp))
+	// Output:
+	// USD 157.62 <nil>
+	// USD 157.63 <nil>
+}
+
+func ExampleAmortizationSchedule() {
+	p := money.MustParseAmount("USD", "100.00")
+	r := decimal.MustParse("0.1")
+	s, _ := finance.AmortizationSchedule(p, r, 3, money.HalfUp)
+	for _, i := range s {
+		fmt.Println(i.Payment, i.Interest, i.Principal, i.Balance)
+	}
+	// Output:
+	// USD 40.21 USD 10.00 USD 30.21 USD 69.79
+	// USD 40.21 USD 6.98 USD 33.23 USD 36.56
+	// USD 40.22 USD 3.66 USD 36.56 USD 0.00
+}
+
+func ExampleAddTax() {
+	a := money.MustParseAmount("USD", "9.99")
+	r := decimal.MustParse("0.075")
+	fmt.Println(finance.AddTax(a, r, money.HalfEven))
+	// Output:
+	// USD 9.99 USD 0.75 <nil>
+}
+
+func ExampleExtractTax() {
+	a := money.MustParseAmount("EUR", "9.99")
+	r := decimal.MustParse("0.2")
+	fmt.Println(finance.ExtractTax(a, r, money.HalfEven))
+	fmt.Println(finance.ExtractTax(a, r, money.HalfUp))
+	// Output:
+	// EUR 8.33 EUR 1.66 <nil>
+	// EUR 8.32 EUR 1.67 <nil>
+}
+
+func ExampleSplitTax() {
+	lines := []money.Amount{
+		money.MustParseAmount("EUR", "1.00"),
+		money.MustParseAmount("EUR", "1.00"),
+		money.MustParseAmount("EUR", "1.00"),
+	}
+	r := decimal.MustParse("0.2")
+	fmt.Println(finance.SplitTax(lines, r, money.HalfEven))
+	// Output:
+	// [EUR 0.83 EUR 0.84 EUR 0.83] [EUR 0.17 EUR 0.16 EUR 0.17] <nil>
+}
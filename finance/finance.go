@@ -0,0 +1,210 @@
+/*
+Package finance implements interest calculations, amortization schedules
+and tax calculations for amounts of money.
+
+Rates are given as decimals, for example 0.005 for a monthly interest rate
+of 0.5% or 0.2 for a VAT of 20%.
+All results are rounded to the scale of the currency using an explicitly
+specified [money.RoundingMode], and schedules and tax splits are constructed
+so that no minor units are lost or created by rounding.
+*/
+package finance
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+// SimpleInterest returns the interest accrued on the principal over
+// the given number of periods without compounding, that is
+// principal * rate * periods, rounded to the scale of the currency
+// using the specified rounding mode.
+// See also function [CompoundInterest].
+//
+// SimpleInterest returns an error if:
+//   - the number of periods is negative;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func SimpleInterest(principal money.Amount, rate decimal.Decimal, periods int, mode money.RoundingMode) (money.Amount, error) {
+	a, err := simpleInterest(principal, rate, periods, mode)
+	if err != nil {
+		return money.Amount{}, fmt.Errorf("computing simple interest on [%v] at %v for %v periods: %w", principal, rate, periods, err)
+	}
+	return a, nil
+}
+
+func simpleInterest(principal money.Amount, rate decimal.Decimal, periods int, mode money.RoundingMode) (money.Amount, error) {
+	if periods < 0 {
+		return money.Amount{}, fmt.Errorf("number of periods must be non-negative")
+	}
+	n, err := decimal.New(int64(periods), 0)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	e, err := rate.Mul(n)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	return principal.MulRound(e, mode)
+}
+
+// CompoundInterest returns the interest accrued on the principal over
+// the given number of periods with compounding at the end of each period,
+// that is principal * ((1 + rate)^periods - 1), rounded to the scale of
+// the currency using the specified rounding mode.
+// The accumulated amount is the sum of the principal and the interest.
+// See also function [SimpleInterest].
+//
+// CompoundInterest returns an error if:
+//   - the number of periods is negative;
+//   - the rate is less than or equal to -1;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func CompoundInterest(principal money.Amount, rate decimal.Decimal, periods int, mode money.RoundingMode) (money.Amount, error) {
+	a, err := compoundInterest(principal, rate, periods, mode)
+	if err != nil {
+		return money.Amount{}, fmt.Errorf("computing compound interest on [%v] at %v for %v periods: %w", principal, rate, periods, err)
+	}
+	return a, nil
+}
+
+func compoundInterest(principal money.Amount, rate decimal.Decimal, periods int, mode money.RoundingMode) (money.Amount, error) {
+	if periods < 0 {
+		return money.Amount{}, fmt.Errorf("number of periods must be non-negative")
+	}
+	f, err := growth(rate, periods)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	e, err := f.Sub(decimal.One)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	return principal.MulRound(e, mode)
+}
+
+// growth returns (1 + rate)^periods.
+func growth(rate decimal.Decimal, periods int) (decimal.Decimal, error) {
+	g, err := rate.Add(decimal.One)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if !g.IsPos() {
+		return decimal.Decimal{}, fmt.Errorf("rate must be greater than -1")
+	}
+	return g.PowInt(periods)
+}
+
+// Installment represents a single period of an amortization schedule.
+type Installment struct {
+	Payment   money.Amount // Amount paid in the period
+	Interest  money.Amount // Part of the payment that covers interest
+	Principal money.Amount // Part of the payment that repays the principal
+	Balance   money.Amount // Principal outstanding after the payment
+}
+
+// AmortizationSchedule returns the schedule of equal periodic payments that
+// repay the principal with interest at the given rate over the given number
+// of periods.
+// Both the payment and the interest of each period are rounded to the scale
+// of the currency using the specified rounding mode, and the last payment
+// is adjusted so that the principal parts of all payments sum up exactly
+// to the principal.
+// If the rate is zero, the principal is split into parts that are as equal
+// as possible.
+//
+// AmortizationSchedule returns an error if:
+//   - the principal is not positive;
+//   - the number of periods is not positive;
+//   - the rate is negative;
+//   - the integer part of any intermediate result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func AmortizationSchedule(principal money.Amount, rate decimal.Decimal, periods int, mode money.RoundingMode) ([]Installment, error) {
+	s, err := amortizationSchedule(principal, rate, periods, mode)
+	if err != nil {
+		return nil, fmt.Errorf("computing amortization schedule for [%v] at %v for %v periods: %w", principal, rate, periods, err)
+	}
+	return s, nil
+}
+
+func amortizationSchedule(principal money.Amount, rate decimal.Decimal, periods int, mode money.RoundingMode) ([]Installment, error) {
+	switch {
+	case !principal.IsPos():
+		return nil, fmt.Errorf("principal must be positive")
+	case periods <= 0:
+		return nil, fmt.Errorf("number of periods must be positive")
+	case rate.IsNeg():
+		return nil, fmt.Errorf("rate must be non-negative")
+	}
+	principal = principal.RoundWith(principal.Curr().Scale(), mode)
+
+	// Zero rate
+	if rate.IsZero() {
+		parts, err := principal.Split(periods)
+		if err != nil {
+			return nil, err
+		}
+		s := make([]Installment, periods)
+		balance := principal
+		for i, p := range parts {
+			balance, err = balance.Sub(p)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = Installment{Payment: p, Interest: principal.Zero(), Principal: p, Balance: balance}
+		}
+		return s, nil
+	}
+
+	// Payment = principal * rate * f / (f - 1), where f = (1 + rate)^periods
+	f, err := growth(rate, periods)
+	if err != nil {
+		return nil, err
+	}
+	num, err := rate.Mul(f)
+	if err != nil {
+		return nil, err
+	}
+	den, err := f.Sub(decimal.One)
+	if err != nil {
+		return nil, err
+	}
+	k, err := num.Quo(den)
+	if err != nil {
+		return nil, err
+	}
+	payment, err := principal.MulRound(k, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	s := make([]Installment, periods)
+	balance := principal
+	for i := range s {
+		interest, err := balance.MulRound(rate, mode)
+		if err != nil {
+			return nil, err
+		}
+		var p money.Amount
+		if i == periods-1 {
+			p = balance
+		} else {
+			p, err = payment.Sub(interest)
+			if err != nil {
+				return nil, err
+			}
+		}
+		pay, err := interest.Add(p)
+		if err != nil {
+			return nil, err
+		}
+		balance, err = balance.Sub(p)
+		if err != nil {
+			return nil, err
+		}
+		s[i] = Installment{Payment: pay, Interest: interest, Principal: p, Balance: balance}
+	}
+	return s, nil
+}
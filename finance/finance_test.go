@@ -0,0 +1,214 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+func TestSimpleInterest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, p, r string
+			n       int
+			mode    money.RoundingMode
+			want    string
+		}{
+			{"USD", "1000.00", "0.05", 3, money.HalfEven, "150.00"},
+			{"USD", "1000.00", "0.05", 0, money.HalfEven, "0.00"},
+			{"USD", "1000.00", "0", 3, money.HalfEven, "0.00"},
+			{"USD", "100.00", "0.00125", 1, money.HalfEven, "0.12"},
+			{"USD", "100.00", "0.00125", 1, money.HalfUp, "0.13"},
+			{"USD", "-100.00", "0.01", 2, money.HalfEven, "-2.00"},
+			{"JPY", "10000", "0.0015", 1, money.Floor, "15"},
+		}
+		for _, tt := range tests {
+			p := money.MustParseAmount(tt.m, tt.p)
+			r := decimal.MustParse(tt.r)
+			got, err := SimpleInterest(p, r, tt.n, tt.mode)
+			if err != nil {
+				t.Errorf("SimpleInterest(%q, %v, %v, %v) failed: %v", p, r, tt.n, tt.mode, err)
+				continue
+			}
+			want := money.MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("SimpleInterest(%q, %v, %v, %v) = %q, want %q", p, r, tt.n, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, p, r string
+			n       int
+		}{
+			"negative periods": {"USD", "1000.00", "0.05", -1},
+			"overflow":         {"USD", "99999999999999999.99", "10", 1},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := money.MustParseAmount(tt.m, tt.p)
+				r := decimal.MustParse(tt.r)
+				_, err := SimpleInterest(p, r, tt.n, money.HalfEven)
+				if err == nil {
+					t.Errorf("SimpleInterest(%q, %v, %v) did not fail", p, r, tt.n)
+				}
+			})
+		}
+	})
+}
+
+func TestCompoundInterest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, p, r string
+			n       int
+			mode    money.RoundingMode
+			want    string
+		}{
+			{"USD", "1000.00", "0.05", 3, money.HalfEven, "157.62"},
+			{"USD", "1000.00", "0.05", 3, money.HalfUp, "157.63"},
+			{"USD", "1000.00", "0.05", 1, money.HalfEven, "50.00"},
+			{"USD", "1000.00", "0.05", 0, money.HalfEven, "0.00"},
+			{"USD", "1000.00", "0", 12, money.HalfEven, "0.00"},
+			{"USD", "1000.00", "-0.01", 2, money.HalfEven, "-19.90"},
+			{"EUR", "10000.00", "0.004", 120, money.HalfEven, "6145.28"},
+		}
+		for _, tt := range tests {
+			p := money.MustParseAmount(tt.m, tt.p)
+			r := decimal.MustParse(tt.r)
+			got, err := CompoundInterest(p, r, tt.n, tt.mode)
+			if err != nil {
+				t.Errorf("CompoundInterest(%q, %v, %v, %v) failed: %v", p, r, tt.n, tt.mode, err)
+				continue
+			}
+			want := money.MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("CompoundInterest(%q, %v, %v, %v) = %q, want %q", p, r, tt.n, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, p, r string
+			n       int
+		}{
+			"negative periods": {"USD", "1000.00", "0.05", -1},
+			"rate 1":           {"USD", "1000.00", "-1", 1},
+			"rate 2":           {"USD", "1000.00", "-2", 1},
+			"overflow":         {"USD", "99999999999999999.99", "1", 10},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := money.MustParseAmount(tt.m, tt.p)
+				r := decimal.MustParse(tt.r)
+				_, err := CompoundInterest(p, r, tt.n, money.HalfEven)
+				if err == nil {
+					t.Errorf("CompoundInterest(%q, %v, %v) did not fail", p, r, tt.n)
+				}
+			})
+		}
+	})
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, p, r      string
+			n            int
+			mode         money.RoundingMode
+			wantPayments []string
+		}{
+			{"USD", "100.00", "0.1", 3, money.HalfUp, []string{"40.21", "40.21", "40.22"}},
+			{"USD", "1000.00", "0", 3, money.HalfUp, []string{"333.34", "333.33", "333.33"}},
+			{"USD", "1000.00", "0.01", 1, money.HalfUp, []string{"1010.00"}},
+			{"JPY", "100000", "0.02", 4, money.HalfEven, []string{"26262", "26262", "26262", "26264"}},
+			{"USD", "1000.004", "0", 2, money.HalfUp, []string{"500.00", "500.00"}},
+		}
+		for _, tt := range tests {
+			p := money.MustParseAmount(tt.m, tt.p)
+			r := decimal.MustParse(tt.r)
+			got, err := AmortizationSchedule(p, r, tt.n, tt.mode)
+			if err != nil {
+				t.Errorf("AmortizationSchedule(%q, %v, %v, %v) failed: %v", p, r, tt.n, tt.mode, err)
+				continue
+			}
+			if len(got) != len(tt.wantPayments) {
+				t.Errorf("AmortizationSchedule(%q, %v, %v, %v) returned %v installments, want %v", p, r, tt.n, tt.mode, len(got), len(tt.wantPayments))
+				continue
+			}
+			for i, inst := range got {
+				want := money.MustParseAmount(tt.m, tt.wantPayments[i])
+				if inst.Payment != want {
+					t.Errorf("AmortizationSchedule(%q, %v, %v, %v)[%v].Payment = %q, want %q", p, r, tt.n, tt.mode, i, inst.Payment, want)
+				}
+			}
+		}
+	})
+
+	t.Run("invariants", func(t *testing.T) {
+		tests := []struct {
+			m, p, r string
+			n       int
+		}{
+			{"USD", "1000.00", "0.01", 12},
+			{"USD", "250000.00", "0.0035", 360},
+			{"EUR", "0.05", "0.02", 7},
+			{"OMR", "1234.567", "0.0075", 48},
+			{"JPY", "3000000", "0.001", 84},
+		}
+		modes := [...]money.RoundingMode{money.HalfEven, money.HalfUp, money.HalfDown, money.Ceiling, money.Floor, money.Truncate}
+		for _, tt := range tests {
+			p := money.MustParseAmount(tt.m, tt.p)
+			r := decimal.MustParse(tt.r)
+			for _, mode := range modes {
+				got, err := AmortizationSchedule(p, r, tt.n, mode)
+				if err != nil {
+					t.Errorf("AmortizationSchedule(%q, %v, %v, %v) failed: %v", p, r, tt.n, mode, err)
+					continue
+				}
+				balance := p
+				for i, inst := range got {
+					if sum, _ := inst.Interest.Add(inst.Principal); sum != inst.Payment {
+						t.Errorf("AmortizationSchedule(%q, %v, %v, %v)[%v]: %q + %q != %q", p, r, tt.n, mode, i, inst.Interest, inst.Principal, inst.Payment)
+					}
+					balance, _ = balance.Sub(inst.Principal)
+					if balance != inst.Balance {
+						t.Errorf("AmortizationSchedule(%q, %v, %v, %v)[%v].Balance = %q, want %q", p, r, tt.n, mode, i, inst.Balance, balance)
+					}
+					if !inst.Payment.SameScaleAsCurr() || !inst.Interest.SameScaleAsCurr() {
+						t.Errorf("AmortizationSchedule(%q, %v, %v, %v)[%v] = %v, not rounded to currency", p, r, tt.n, mode, i, inst)
+					}
+				}
+				if !balance.IsZero() {
+					t.Errorf("AmortizationSchedule(%q, %v, %v, %v) left balance %q", p, r, tt.n, mode, balance)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, p, r string
+			n       int
+		}{
+			"zero principal":     {"USD", "0", "0.01", 12},
+			"negative principal": {"USD", "-1000.00", "0.01", 12},
+			"zero periods":       {"USD", "1000.00", "0.01", 0},
+			"negative rate":      {"USD", "1000.00", "-0.01", 12},
+			"overflow":           {"USD", "99999999999999999.99", "1", 10},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := money.MustParseAmount(tt.m, tt.p)
+				r := decimal.MustParse(tt.r)
+				_, err := AmortizationSchedule(p, r, tt.n, money.HalfEven)
+				if err == nil {
+					t.Errorf("AmortizationSchedule(%q, %v, %v) did not fail", p, r, tt.n)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+// AddTax returns the net amount and the tax charged on it at the given rate,
+// for example 0.2 for a VAT of 20%.
+// The net amount is rounded to the scale of the currency, and the tax is
+// computed from it and rounded using the specified rounding mode, so that the
+// gross amount is exactly net + tax.
+// The gross amount itself is not computed, so adding the results can still
+// overflow even though AddTax succeeds.
+// See also function [ExtractTax].
+//
+// AddTax returns an error if:
+//   - the rate is negative;
+//   - the integer part of the net amount or the tax has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func AddTax(net money.Amount, rate decimal.Decimal, mode money.RoundingMode) (n, tax money.Amount, err error) {
+	n, tax, err = addTax(net, rate, mode)
+	if err != nil {
+		return money.Amount{}, money.Amount{}, fmt.Errorf("computing tax on [%v] at %v: %w", net, rate, err)
+	}
+	return n, tax, nil
+}
+
+func addTax(net money.Amount, rate decimal.Decimal, mode money.RoundingMode) (n, tax money.Amount, err error) {
+	if rate.IsNeg() {
+		return money.Amount{}, money.Amount{}, fmt.Errorf("tax rate must be non-negative")
+	}
+	n = net.RoundWith(net.Curr().Scale(), mode)
+	tax, err = n.MulRound(rate, mode)
+	if err != nil {
+		return money.Amount{}, money.Amount{}, err
+	}
+	return n, tax, nil
+}
+
+// ExtractTax splits a tax-inclusive gross amount into the net amount and
+// the tax included in it at the given rate, for example 0.2 for a VAT of 20%.
+// The tax is gross * rate / (1 + rate) rounded to the scale of the currency
+// using the specified rounding mode, and the net amount is the difference,
+// so that net + tax is exactly equal to the gross amount rounded to the
+// scale of the currency.
+// See also functions [AddTax] and [SplitTax].
+//
+// ExtractTax returns an error if:
+//   - the rate is negative;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func ExtractTax(gross money.Amount, rate decimal.Decimal, mode money.RoundingMode) (net, tax money.Amount, err error) {
+	net, tax, err = extractTax(gross, rate, mode)
+	if err != nil {
+		return money.Amount{}, money.Amount{}, fmt.Errorf("extracting tax from [%v] at %v: %w", gross, rate, err)
+	}
+	return net, tax, nil
+}
+
+func extractTax(gross money.Amount, rate decimal.Decimal, mode money.RoundingMode) (net, tax money.Amount, err error) {
+	if rate.IsNeg() {
+		return money.Amount{}, money.Amount{}, fmt.Errorf("tax rate must be non-negative")
+	}
+	gross = gross.RoundWith(gross.Curr().Scale(), mode)
+	tax, err = includedTax(gross, rate, mode)
+	if err != nil {
+		return money.Amount{}, money.Amount{}, err
+	}
+	net, err = gross.Sub(tax)
+	if err != nil {
+		return money.Amount{}, money.Amount{}, err
+	}
+	return net, tax, nil
+}
+
+// includedTax returns gross * rate / (1 + rate), the tax included in
+// the tax-inclusive gross amount, rounded to the scale of the currency.
+// The product is computed first, so that exact halves are rounded according
+// to the rounding mode.
+func includedTax(gross money.Amount, rate decimal.Decimal, mode money.RoundingMode) (money.Amount, error) {
+	d, err := rate.Add(decimal.One)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	t, err := gross.Mul(rate)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	return t.QuoRound(d, mode)
+}
+
+// SplitTax splits tax-inclusive gross amounts of invoice lines into net
+// amounts and taxes at the given rate, for example 0.2 for a VAT of 20%.
+// Unlike calling [ExtractTax] for every line, which rounds the tax of each
+// line separately, SplitTax rounds the tax once for the whole invoice and
+// then distributes it among the lines, so that:
+//   - the net amount and the tax of each line sum up exactly to its gross
+//     amount rounded to the scale of the currency;
+//   - the taxes of all lines sum up exactly to the tax that [ExtractTax]
+//     returns for the sum of the gross amounts of the lines, each rounded
+//     to the scale of the currency first, which may differ from the tax of
+//     the unrounded sum;
+//   - the tax of each line differs from its exact value by at most one
+//     minor unit.
+//
+// SplitTax returns an error if:
+//   - the lines are denominated in different currencies;
+//   - the rate is negative;
+//   - the integer part of any intermediate result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func SplitTax(gross []money.Amount, rate decimal.Decimal, mode money.RoundingMode) (net, tax []money.Amount, err error) {
+	net, tax, err = splitTax(gross, rate, mode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("splitting tax from %v at %v: %w", gross, rate, err)
+	}
+	return net, tax, nil
+}
+
+func splitTax(gross []money.Amount, rate decimal.Decimal, mode money.RoundingMode) (net, tax []money.Amount, err error) {
+	if rate.IsNeg() {
+		return nil, nil, fmt.Errorf("tax rate must be non-negative")
+	}
+	if len(gross) == 0 {
+		return nil, nil, nil
+	}
+
+	// The tax of a line is the difference between the rounded taxes of
+	// the running totals up to and including the line, and up to the
+	// previous line.
+	net = make([]money.Amount, len(gross))
+	tax = make([]money.Amount, len(gross))
+	total := gross[0].Zero().RoundToCurr()
+	prev := total
+	for i, g := range gross {
+		g = g.RoundWith(g.Curr().Scale(), mode)
+		total, err = total.Add(g)
+		if err != nil {
+			return nil, nil, err
+		}
+		cum, err := includedTax(total, rate, mode)
+		if err != nil {
+			return nil, nil, err
+		}
+		tax[i], err = cum.Sub(prev)
+		if err != nil {
+			return nil, nil, err
+		}
+		net[i], err = g.Sub(tax[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		prev = cum
+	}
+	return net, tax, nil
+}
@@ -0,0 +1,222 @@
+package finance
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+func TestAddTax(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, a, r          string
+			mode             money.RoundingMode
+			wantNet, wantTax string
+		}{
+			{"USD", "100.00", "0.2", money.HalfEven, "100.00", "20.00"},
+			{"USD", "100", "0.2", money.HalfEven, "100.00", "20.00"},
+			{"USD", "9.99", "0.075", money.HalfEven, "9.99", "0.75"},
+			{"USD", "0.05", "0.19", money.HalfEven, "0.05", "0.01"},
+			{"USD", "0.05", "0.19", money.Floor, "0.05", "0.00"},
+			{"USD", "0.125", "0", money.HalfEven, "0.12", "0.00"},
+			{"USD", "-10.00", "0.2", money.HalfEven, "-10.00", "-2.00"},
+			{"JPY", "999", "0.1", money.HalfUp, "999", "100"},
+		}
+		for _, tt := range tests {
+			a := money.MustParseAmount(tt.m, tt.a)
+			r := decimal.MustParse(tt.r)
+			gotNet, gotTax, err := AddTax(a, r, tt.mode)
+			if err != nil {
+				t.Errorf("AddTax(%q, %v, %v) failed: %v", a, r, tt.mode, err)
+				continue
+			}
+			wantNet := money.MustParseAmount(tt.m, tt.wantNet)
+			wantTax := money.MustParseAmount(tt.m, tt.wantTax)
+			if gotNet != wantNet || gotTax != wantTax {
+				t.Errorf("AddTax(%q, %v, %v) = (%q, %q), want (%q, %q)", a, r, tt.mode, gotNet, gotTax, wantNet, wantTax)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, a, r string
+		}{
+			"negative rate": {"USD", "100.00", "-0.2"},
+			"overflow 1":    {"USD", "99999999999999999.99", "10"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := money.MustParseAmount(tt.m, tt.a)
+				r := decimal.MustParse(tt.r)
+				_, _, err := AddTax(a, r, money.HalfEven)
+				if err == nil {
+					t.Errorf("AddTax(%q, %v) did not fail", a, r)
+				}
+			})
+		}
+	})
+}
+
+func TestExtractTax(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, a, r          string
+			mode             money.RoundingMode
+			wantNet, wantTax string
+		}{
+			{"USD", "120.00", "0.2", money.HalfEven, "100.00", "20.00"},
+			{"USD", "9.99", "0.2", money.HalfEven, "8.33", "1.66"},
+			{"USD", "9.99", "0.2", money.HalfUp, "8.32", "1.67"},
+			{"USD", "0.00", "0.2", money.HalfEven, "0.00", "0.00"},
+			{"USD", "-120.00", "0.2", money.HalfEven, "-100.00", "-20.00"},
+			{"EUR", "10.00", "0", money.HalfEven, "10.00", "0.00"},
+			{"EUR", "1.00", "0.19", money.HalfEven, "0.84", "0.16"},
+			{"JPY", "1100", "0.1", money.HalfEven, "1000", "100"},
+		}
+		for _, tt := range tests {
+			a := money.MustParseAmount(tt.m, tt.a)
+			r := decimal.MustParse(tt.r)
+			gotNet, gotTax, err := ExtractTax(a, r, tt.mode)
+			if err != nil {
+				t.Errorf("ExtractTax(%q, %v, %v) failed: %v", a, r, tt.mode, err)
+				continue
+			}
+			wantNet := money.MustParseAmount(tt.m, tt.wantNet)
+			wantTax := money.MustParseAmount(tt.m, tt.wantTax)
+			if gotNet != wantNet || gotTax != wantTax {
+				t.Errorf("ExtractTax(%q, %v, %v) = (%q, %q), want (%q, %q)", a, r, tt.mode, gotNet, gotTax, wantNet, wantTax)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, a, r string
+		}{
+			"negative rate": {"USD", "120.00", "-0.2"},
+			"overflow":      {"USD", "99999999999999999.99", "10"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := money.MustParseAmount(tt.m, tt.a)
+				r := decimal.MustParse(tt.r)
+				_, _, err := ExtractTax(a, r, money.HalfEven)
+				if err == nil {
+					t.Errorf("ExtractTax(%q, %v) did not fail", a, r)
+				}
+			})
+		}
+	})
+}
+
+func TestSplitTax(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m                string
+			gross            []string
+			r                string
+			mode             money.RoundingMode
+			wantNet, wantTax []string
+		}{
+			{"USD", []string{}, "0.2", money.HalfEven, []string{}, []string{}},
+			{"USD", []string{"120.00"}, "0.2", money.HalfEven, []string{"100.00"}, []string{"20.00"}},
+			{"USD", []string{"1.00", "1.00", "1.00"}, "0.2", money.HalfEven, []string{"0.83", "0.84", "0.83"}, []string{"0.17", "0.16", "0.17"}},
+			{"USD", []string{"1.00", "-1.00"}, "0.2", money.HalfEven, []string{"0.83", "-0.83"}, []string{"0.17", "-0.17"}},
+			{"JPY", []string{"100", "100", "100"}, "0.08", money.HalfEven, []string{"93", "92", "93"}, []string{"7", "8", "7"}},
+			{"USD", []string{"3.005", "3.005"}, "0.2", money.HalfEven, []string{"2.50", "2.50"}, []string{"0.50", "0.50"}},
+		}
+		for _, tt := range tests {
+			gross := make([]money.Amount, len(tt.gross))
+			for i, s := range tt.gross {
+				gross[i] = money.MustParseAmount(tt.m, s)
+			}
+			r := decimal.MustParse(tt.r)
+			gotNet, gotTax, err := SplitTax(gross, r, tt.mode)
+			if err != nil {
+				t.Errorf("SplitTax(%v, %v, %v) failed: %v", gross, r, tt.mode, err)
+				continue
+			}
+			if len(gotNet) != len(tt.wantNet) || len(gotTax) != len(tt.wantTax) {
+				t.Errorf("SplitTax(%v, %v, %v) = (%v, %v), want (%v, %v)", gross, r, tt.mode, gotNet, gotTax, tt.wantNet, tt.wantTax)
+				continue
+			}
+			for i := range gotNet {
+				wantNet := money.MustParseAmount(tt.m, tt.wantNet[i])
+				wantTax := money.MustParseAmount(tt.m, tt.wantTax[i])
+				if gotNet[i] != wantNet || gotTax[i] != wantTax {
+					t.Errorf("SplitTax(%v, %v, %v)[%v] = (%q, %q), want (%q, %q)", gross, r, tt.mode, i, gotNet[i], gotTax[i], wantNet, wantTax)
+				}
+			}
+		}
+	})
+
+	t.Run("invariants", func(t *testing.T) {
+		tests := []struct {
+			m     string
+			gross []string
+			r     string
+		}{
+			{"USD", []string{"9.99", "0.01", "19.95", "4.49", "100.00"}, "0.2"},
+			{"EUR", []string{"0.99", "0.99", "0.99", "0.99", "0.99", "0.99", "0.99"}, "0.19"},
+			{"EUR", []string{"12.50", "-3.10", "7.77", "0.05"}, "0.07"},
+			{"OMR", []string{"1.005", "2.995", "0.333"}, "0.05"},
+			{"JPY", []string{"198", "298", "1"}, "0.1"},
+		}
+		modes := [...]money.RoundingMode{money.HalfEven, money.HalfUp, money.HalfDown, money.Ceiling, money.Floor, money.Truncate}
+		for _, tt := range tests {
+			gross := make([]money.Amount, len(tt.gross))
+			for i, s := range tt.gross {
+				gross[i] = money.MustParseAmount(tt.m, s)
+			}
+			r := decimal.MustParse(tt.r)
+			for _, mode := range modes {
+				gotNet, gotTax, err := SplitTax(gross, r, mode)
+				if err != nil {
+					t.Errorf("SplitTax(%v, %v, %v) failed: %v", gross, r, mode, err)
+					continue
+				}
+				total := gross[0].Zero()
+				totalTax := gross[0].Zero()
+				for i, g := range gross {
+					if sum, _ := gotNet[i].Add(gotTax[i]); sum != g {
+						t.Errorf("SplitTax(%v, %v, %v)[%v]: %q + %q != %q", gross, r, mode, i, gotNet[i], gotTax[i], g)
+					}
+					d, _ := r.Add(decimal.One)
+					exact, _ := g.Mul(r)
+					exact, _ = exact.Quo(d)
+					if diff, _ := gotTax[i].SubAbs(exact); diff.Decimal().Cmp(g.ULP().Decimal()) > 0 {
+						t.Errorf("SplitTax(%v, %v, %v)[%v].Tax = %q, too far from %q", gross, r, mode, i, gotTax[i], exact)
+					}
+					total, _ = total.Add(g)
+					totalTax, _ = totalTax.Add(gotTax[i])
+				}
+				_, wantTax, _ := ExtractTax(total, r, mode)
+				if totalTax != wantTax {
+					t.Errorf("SplitTax(%v, %v, %v) total tax = %q, want %q", gross, r, mode, totalTax, wantTax)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			gross []money.Amount
+			r     string
+		}{
+			"currency mismatch": {[]money.Amount{money.MustParseAmount("USD", "1.00"), money.MustParseAmount("EUR", "1.00")}, "0.2"},
+			"negative rate":     {[]money.Amount{money.MustParseAmount("USD", "1.00")}, "-0.2"},
+			"overflow":          {[]money.Amount{money.MustParseAmount("USD", "99999999999999999.99"), money.MustParseAmount("USD", "1.00")}, "0.2"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := decimal.MustParse(tt.r)
+				_, _, err := SplitTax(tt.gross, r, money.HalfEven)
+				if err == nil {
+					t.Errorf("SplitTax(%v, %v) did not fail", tt.gross, r)
+				}
+			})
+		}
+	})
+}
@@ -1,5 +1,8 @@
 module github.com/lunafinancialgroup/money
 
-go 1.22
+go 1.23
 
-require github.com/govalues/decimal v0.1.36
+require (
+	github.com/govalues/decimal v0.1.36
+	golang.org/x/text v0.22.0
+)
@@ -0,0 +1,39 @@
+// Code generated by scripts/currency/codegen.go. DO NOT EDIT.
+// Any changes made to this file will be overwritten the next time it is generated.
+
+package money
+
+var histLookup = map[string]histCurr{
+	"ADP": {"020", 0}, // Andorran Peseta
+	"ATS": {"040", 2}, // Schilling
+	"BEF": {"056", 2}, // Belgian Franc
+	"BYR": {"974", 0}, // Belarusian Ruble
+	"CSK": {"200", 2}, // Koruna
+	"CYP": {"196", 2}, // Cyprus Pound
+	"DDM": {"278", 2}, // Mark der DDR
+	"DEM": {"276", 2}, // Deutsche Mark
+	"EEK": {"233", 2}, // Kroon
+	"ESP": {"724", 0}, // Spanish Peseta
+	"FIM": {"246", 2}, // Markka
+	"FRF": {"250", 2}, // French Franc
+	"GRD": {"300", 2}, // Drachma
+	"HRK": {"191", 2}, // Kuna
+	"IEP": {"372", 2}, // Irish Pound
+	"ITL": {"380", 0}, // Italian Lira
+	"LTL": {"440", 2}, // Lithuanian Litas
+	"LUF": {"442", 0}, // Luxembourg Franc
+	"LVL": {"428", 2}, // Latvian Lats
+	"MRO": {"478", 0}, // Ouguiya
+	"MTL": {"470", 2}, // Maltese Lira
+	"NLG": {"528", 2}, // Netherlands Guilder
+	"PTE": {"620", 0}, // Portuguese Escudo
+	"SIT": {"705", 2}, // Tolar
+	"SKK": {"703", 2}, // Slovak Koruna
+	"SLL": {"694", 0}, // Leone
+	"STD": {"678", 0}, // Dobra
+	"TRL": {"792", 0}, // Old Turkish Lira
+	"VEF": {"937", 2}, // Bolívar
+	"XEU": {"954", 2}, // European Currency Unit (E.C.U)
+	"YUM": {"891", 2}, // New Yugoslavian Dinar
+	"ZWL": {"932", 2}, // Zimbabwe Dollar
+}
@@ -0,0 +1,24 @@
+package interop_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/interop"
+)
+
+func ExampleNewAmountFromRat() {
+	third := big.NewRat(100, 3)
+	fmt.Println(interop.NewAmountFromRat("USD", third, money.HalfEven))
+	fmt.Println(interop.NewAmountFromRat("USD", third, money.Ceiling))
+	// Output:
+	// USD 33.33 <nil>
+	// USD 33.34 <nil>
+}
+
+func ExampleRat() {
+	a := money.MustParseAmount("USD", "12.50")
+	fmt.Println(interop.Rat(a))
+	// Output: 25/2
+}
@@ -0,0 +1,131 @@
+/*
+Package interop implements conversions between amounts and other
+representations of decimal numbers, so that the boundaries between this
+library and existing code are exact and explicit about rounding.
+
+Conversions to and from [big.Rat] are implemented in this package, which
+depends only on the standard library:
+  - [NewAmountFromRat] rounds a rational number to the scale of a currency.
+  - [Rat] returns the exact rational value of an amount.
+
+Conversions to and from [shopspring/decimal] are implemented in the separate
+module [github.com/lunafinancialgroup/money/interop/shopspring], so that
+the money module itself does not depend on it.
+
+[big.Rat]: https://pkg.go.dev/math/big#Rat
+[shopspring/decimal]: https://pkg.go.dev/github.com/shopspring/decimal
+[github.com/lunafinancialgroup/money/interop/shopspring]: https://pkg.go.dev/github.com/lunafinancialgroup/money/interop/shopspring
+*/
+package interop
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+// NewAmountFromRat returns an amount equal to the rational number r rounded
+// to the scale of the currency using the specified rounding mode.
+// See also function [Rat].
+//
+// NewAmountFromRat returns an error if:
+//   - the currency code is not valid;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func NewAmountFromRat(curr string, r *big.Rat, mode money.RoundingMode) (money.Amount, error) {
+	a, err := newAmountFromRat(curr, r, mode)
+	if err != nil {
+		return money.Amount{}, fmt.Errorf("converting %v to amount: %w", r.RatString(), err)
+	}
+	return a, nil
+}
+
+func newAmountFromRat(curr string, r *big.Rat, mode money.RoundingMode) (money.Amount, error) {
+	m, err := money.ParseCurr(curr)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	scale := m.Scale()
+	// Coefficient of the result is r * 10^scale rounded to an integer
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	coef := quoRound(num, r.Denom(), mode)
+	if coef.CmpAbs(maxCoef) > 0 {
+		return money.Amount{}, money.ErrOverflow
+	}
+	d, err := decimal.ParseExact(decimalString(coef, scale), scale)
+	if err != nil {
+		return money.Amount{}, err
+	}
+	return money.NewAmountFromDecimal(m, d)
+}
+
+// Rat returns the exact value of the amount as a rational number.
+// See also function [NewAmountFromRat].
+func Rat(a money.Amount) *big.Rat {
+	d := a.Decimal()
+	num := new(big.Int).SetUint64(d.Coef())
+	if d.IsNeg() {
+		num.Neg(num)
+	}
+	return new(big.Rat).SetFrac(num, pow10(d.Scale()))
+}
+
+// maxCoef is the largest coefficient of a decimal.
+var maxCoef = new(big.Int).SetUint64(9_999_999_999_999_999_999)
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// quoRound returns x / y rounded to an integer using the specified rounding
+// mode, y must be positive.
+func quoRound(x, y *big.Int, mode money.RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// Compare the remainder with the half of the divisor
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(y)
+	var up bool // away from zero
+	switch mode {
+	case money.HalfEven:
+		up = cmp > 0 || cmp == 0 && q.Bit(0) == 1
+	case money.HalfUp:
+		up = cmp >= 0
+	case money.HalfDown:
+		up = cmp > 0
+	case money.Ceiling:
+		up = x.Sign() > 0
+	case money.Floor:
+		up = x.Sign() < 0
+	case money.Truncate:
+		up = false
+	}
+	if up {
+		q.Add(q, big.NewInt(int64(x.Sign())))
+	}
+	return q
+}
+
+// decimalString returns the string representation of coef * 10^-scale,
+// for example "-0.05" for coef -5 and scale 2.
+func decimalString(coef *big.Int, scale int) string {
+	digits := new(big.Int).Abs(coef).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	s := digits
+	if scale > 0 {
+		s = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if coef.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
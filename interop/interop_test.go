@@ -0,0 +1,105 @@
+package interop
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/lunafinancialgroup/money"
+)
+
+func TestNewAmountFromRat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, r string
+			mode    money.RoundingMode
+			want    string
+		}{
+			{"USD", "0", money.HalfEven, "0.00"},
+			{"USD", "1234.5", money.HalfEven, "1234.50"},
+			{"USD", "1/3", money.HalfEven, "0.33"},
+			{"USD", "2/3", money.HalfEven, "0.67"},
+			{"USD", "-2/3", money.HalfEven, "-0.67"},
+			{"JPY", "1/3", money.Ceiling, "1"},
+			{"JPY", "-1/3", money.Ceiling, "0"},
+			{"JPY", "-1/3", money.Floor, "-1"},
+			{"JPY", "5/3", money.Truncate, "1"},
+			{"JPY", "-5/3", money.Truncate, "-1"},
+			{"OMR", "0.0005", money.HalfEven, "0.000"},
+			{"OMR", "0.0015", money.HalfEven, "0.002"},
+			{"OMR", "-0.0015", money.HalfEven, "-0.002"},
+			{"OMR", "0.0015", money.HalfDown, "0.001"},
+			{"OMR", "0.0015", money.HalfUp, "0.002"},
+			{"OMR", "-0.0015", money.HalfUp, "-0.002"},
+			{"OMR", "-0.0015", money.HalfDown, "-0.001"},
+			{"USD", "99999999999999999.99", money.HalfEven, "99999999999999999.99"},
+			{"USD", "-99999999999999999.994", money.HalfEven, "-99999999999999999.99"},
+		}
+		for _, tt := range tests {
+			r, ok := new(big.Rat).SetString(tt.r)
+			if !ok {
+				t.Fatalf("SetString(%q) failed", tt.r)
+			}
+			got, err := NewAmountFromRat(tt.curr, r, tt.mode)
+			if err != nil {
+				t.Errorf("NewAmountFromRat(%q, %q, %v) failed: %v", tt.curr, tt.r, tt.mode, err)
+				continue
+			}
+			want := money.MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("NewAmountFromRat(%q, %q, %v) = %q, want %q", tt.curr, tt.r, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, r string
+			want    error
+		}{
+			"unknown currency": {"UUU", "1", nil},
+			"overflow":         {"USD", "99999999999999999.995", money.ErrOverflow},
+			"large":            {"USD", "1e30", money.ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r, _ := new(big.Rat).SetString(tt.r)
+				_, err := NewAmountFromRat(tt.curr, r, money.HalfEven)
+				if err == nil {
+					t.Fatalf("NewAmountFromRat(%q, %q) did not fail", tt.curr, tt.r)
+				}
+				if tt.want != nil && !errors.Is(err, tt.want) {
+					t.Errorf("NewAmountFromRat(%q, %q) = %v, want %v", tt.curr, tt.r, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRat(t *testing.T) {
+	tests := []struct {
+		curr, amount, want string
+	}{
+		{"USD", "0", "0"},
+		{"USD", "1.25", "5/4"},
+		{"USD", "-0.10", "-1/10"},
+		{"JPY", "1000", "1000"},
+		{"USD", "99999999999999999.99", "9999999999999999999/100"},
+	}
+	for _, tt := range tests {
+		a := money.MustParseAmount(tt.curr, tt.amount)
+		got := Rat(a)
+		if got.RatString() != tt.want {
+			t.Errorf("Rat(%q) = %v, want %v", a, got.RatString(), tt.want)
+		}
+		// Round trip
+		b, err := NewAmountFromRat(tt.curr, got, money.HalfEven)
+		if err != nil {
+			t.Errorf("NewAmountFromRat(%q, %v) failed: %v", tt.curr, got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("NewAmountFromRat(%q, %v) = %q, want %q", tt.curr, got, b, a)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package shopspring_test
+
+import (
+	"fmt"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/interop/shopspring"
+	"github.com/shopspring/decimal"
+)
+
+func ExampleNewAmountFromDecimal() {
+	d := decimal.RequireFromString("19.995")
+	fmt.Println(shopspring.NewAmountFromDecimal("USD", d, money.HalfEven))
+	fmt.Println(shopspring.NewAmountFromDecimal("USD", d, money.Truncate))
+	// Output:
+	// USD 20.00 <nil>
+	// USD 19.99 <nil>
+}
+
+func ExampleDecimal() {
+	a := money.MustParseAmount("USD", "12.50")
+	d := shopspring.Decimal(a)
+	fmt.Println(d.StringFixed(2))
+	// Output: 12.50
+}
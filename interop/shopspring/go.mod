@@ -0,0 +1,15 @@
+module github.com/lunafinancialgroup/money/interop/shopspring
+
+go 1.23
+
+require (
+	github.com/lunafinancialgroup/money v0.0.0
+	github.com/shopspring/decimal v1.4.0
+)
+
+require (
+	github.com/govalues/decimal v0.1.36 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+replace github.com/lunafinancialgroup/money => ../..
@@ -0,0 +1,52 @@
+/*
+Package shopspring implements conversions between amounts and decimals of
+the [shopspring/decimal] package.
+
+It is a separate module, so that the money module does not depend on
+shopspring/decimal:
+  - [NewAmountFromDecimal] rounds a decimal to the scale of a currency.
+  - [Decimal] returns the exact value of an amount as a decimal.
+
+[shopspring/decimal]: https://pkg.go.dev/github.com/shopspring/decimal
+*/
+package shopspring
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/interop"
+	"github.com/shopspring/decimal"
+)
+
+// NewAmountFromDecimal returns an amount equal to the decimal d rounded to
+// the scale of the currency using the specified rounding mode.
+// Unlike [money.NewAmountFromDecimal], it accepts decimals with any number of
+// digits after the decimal point, so the rounding at the boundary between
+// the two packages is always explicit.
+// See also function [Decimal].
+//
+// NewAmountFromDecimal returns an error if:
+//   - the currency code is not valid;
+//   - the integer part of the result has more than
+//     ([github.com/govalues/decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func NewAmountFromDecimal(curr string, d decimal.Decimal, mode money.RoundingMode) (money.Amount, error) {
+	a, err := interop.NewAmountFromRat(curr, d.Rat(), mode)
+	if err != nil {
+		return money.Amount{}, fmt.Errorf("converting %v to amount: %w", d, err)
+	}
+	return a, nil
+}
+
+// Decimal returns the exact value of the amount as a decimal, with the same
+// number of digits after the decimal point as the amount.
+// See also function [NewAmountFromDecimal].
+func Decimal(a money.Amount) decimal.Decimal {
+	d := a.Decimal()
+	coef := new(big.Int).SetUint64(d.Coef())
+	if d.IsNeg() {
+		coef.Neg(coef)
+	}
+	return decimal.NewFromBigInt(coef, -int32(d.Scale())) //nolint:gosec
+}
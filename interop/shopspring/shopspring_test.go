@@ -0,0 +1,90 @@
+package shopspring
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/shopspring/decimal"
+)
+
+func TestNewAmountFromDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, d string
+			mode    money.RoundingMode
+			want    string
+		}{
+			{"USD", "0", money.HalfEven, "0.00"},
+			{"USD", "1234.5", money.HalfEven, "1234.50"},
+			{"USD", "0.125", money.HalfEven, "0.12"},
+			{"USD", "0.125", money.HalfUp, "0.13"},
+			{"USD", "-0.125", money.Floor, "-0.13"},
+			{"USD", "0.3333333333333333333333333333333333", money.HalfEven, "0.33"},
+			{"JPY", "1e3", money.HalfEven, "1000"},
+			{"OMR", "1.23456789", money.Truncate, "1.234"},
+		}
+		for _, tt := range tests {
+			d := decimal.RequireFromString(tt.d)
+			got, err := NewAmountFromDecimal(tt.curr, d, tt.mode)
+			if err != nil {
+				t.Errorf("NewAmountFromDecimal(%q, %v, %v) failed: %v", tt.curr, d, tt.mode, err)
+				continue
+			}
+			want := money.MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("NewAmountFromDecimal(%q, %v, %v) = %q, want %q", tt.curr, d, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, d string
+			want    error
+		}{
+			"unknown currency": {"UUU", "1", nil},
+			"overflow":         {"USD", "1e20", money.ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := decimal.RequireFromString(tt.d)
+				_, err := NewAmountFromDecimal(tt.curr, d, money.HalfEven)
+				if err == nil {
+					t.Fatalf("NewAmountFromDecimal(%q, %v) did not fail", tt.curr, d)
+				}
+				if tt.want != nil && !errors.Is(err, tt.want) {
+					t.Errorf("NewAmountFromDecimal(%q, %v) = %v, want %v", tt.curr, d, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		curr, amount, want string
+	}{
+		{"USD", "0", "0.00"},
+		{"USD", "1.25", "1.25"},
+		{"USD", "-0.1", "-0.10"},
+		{"JPY", "1000", "1000"},
+		{"USD", "99999999999999999.99", "99999999999999999.99"},
+	}
+	for _, tt := range tests {
+		a := money.MustParseAmount(tt.curr, tt.amount)
+		got := Decimal(a)
+		if got.StringFixed(-got.Exponent()) != tt.want {
+			t.Errorf("Decimal(%q) = %v, want %v", a, got, tt.want)
+		}
+		// Round trip
+		b, err := NewAmountFromDecimal(tt.curr, got, money.HalfEven)
+		if err != nil {
+			t.Errorf("NewAmountFromDecimal(%q, %v) failed: %v", tt.curr, got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("NewAmountFromDecimal(%q, %v) = %q, want %q", tt.curr, got, b, a)
+		}
+	}
+}
@@ -0,0 +1,537 @@
+package money
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+)
+
+// Formatter formats amounts according to the conventions of a particular
+// language and region, such as the decimal and thousands separators,
+// the currency symbol, and its placement.
+// The conventions are generated from the [CLDR] for the most widely used
+// locales.
+//
+// [CLDR]: https://cldr.unicode.org
+type Formatter struct {
+	loc    locale
+	scales map[Currency]int // display scales that override Currency.Scale
+	pat    *pattern         // custom pattern that overrides the locale conventions
+	neg    NegativeStyle    // display of negative amounts
+}
+
+// NegativeStyle represents a convention for displaying negative amounts,
+// see [Formatter.WithNegativeStyle].
+type NegativeStyle int8
+
+const (
+	// NegativeMinus displays negative amounts with a leading minus sign,
+	// for example "-$1,234.56".
+	NegativeMinus NegativeStyle = iota
+	// NegativeParens displays negative amounts in parentheses, as in
+	// accounting reports, for example "($1,234.56)".
+	NegativeParens
+	// NegativeTrailingMinus displays negative amounts with a trailing minus
+	// sign, as in bank files and ERP exports, for example "$1,234.56-".
+	NegativeTrailingMinus
+	// NegativeCreditDebit displays amounts without a sign, followed by "CR"
+	// for negative amounts and "DR" for positive amounts, for example
+	// "$1,234.56 CR".
+	// This is the same convention as in [NewEntryFromSigned].
+	NegativeCreditDebit
+)
+
+// String implements the [fmt.Stringer] interface and returns the name of
+// the style.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s NegativeStyle) String() string {
+	switch s {
+	case NegativeMinus:
+		return "NegativeMinus"
+	case NegativeParens:
+		return "NegativeParens"
+	case NegativeTrailingMinus:
+		return "NegativeTrailingMinus"
+	case NegativeCreditDebit:
+		return "NegativeCreditDebit"
+	default:
+		return fmt.Sprintf("NegativeStyle(%d)", int8(s))
+	}
+}
+
+// NewFormatter returns a formatter for the locale identified by a [BCP 47]
+// language tag, for example "de-DE" or "fr-CA".
+// If there are no conventions for the language and region of the tag,
+// the conventions for the language are used.
+// If there are no conventions for the language either, the English
+// conventions are used.
+//
+// NewFormatter returns an error if the tag is not well-formed.
+//
+// [BCP 47]: https://www.rfc-editor.org/info/bcp47
+func NewFormatter(tag string) (Formatter, error) {
+	t, err := language.Parse(tag)
+	if err != nil {
+		return Formatter{}, fmt.Errorf("parsing locale %q: %w", tag, err)
+	}
+	return Formatter{loc: lookupLocale(t)}, nil
+}
+
+// MustNewFormatter is like [NewFormatter] but panics if the tag cannot be parsed.
+// It simplifies safe initialization of global variables holding formatters.
+func MustNewFormatter(tag string) Formatter {
+	f, err := NewFormatter(tag)
+	if err != nil {
+		panic(fmt.Sprintf("NewFormatter(%q) failed: %v", tag, err))
+	}
+	return f
+}
+
+// WithScale returns a copy of the formatter that displays amounts in
+// the given currency with the given number of digits after the decimal point
+// instead of the scale of the currency, for example Japanese Yen with
+// a scale of 2 for interchange fees, or US Dollars with a scale of 0 for
+// price tags.
+// The formatter itself is not modified.
+// See also function [SetCurrScale].
+//
+// WithScale returns an error if the scale is negative or greater than
+// [decimal.MaxScale].
+func (f Formatter) WithScale(curr Currency, scale int) (Formatter, error) {
+	if scale < 0 || scale > decimal.MaxScale {
+		return Formatter{}, fmt.Errorf("setting display scale of currency %v: scale must be between 0 and %v", curr, decimal.MaxScale)
+	}
+	scales := make(map[Currency]int, len(f.scales)+1)
+	maps.Copy(scales, f.scales)
+	scales[curr] = scale
+	f.scales = scales
+	return f, nil
+}
+
+// WithPattern returns a copy of the formatter that displays amounts using
+// a number pattern in the [CLDR syntax] instead of the conventions of
+// the locale.
+// The decimal and thousands separators and the currency symbols of the locale
+// are still used.
+// The formatter itself is not modified.
+//
+// A pattern consists of a positive subpattern and an optional negative
+// subpattern separated by a semicolon, for example "¤#,##0.00;(¤#,##0.00)"
+// for accounting parentheses.
+// If the negative subpattern is omitted, negative amounts are displayed using
+// the positive subpattern prefixed with a minus sign.
+// Each subpattern consists of a prefix, a number, and a suffix:
+//   - ¤ in the prefix or suffix stands for the currency symbol, and ¤¤ for
+//     the currency code.
+//   - Other characters in the prefix and suffix are displayed as is, and can be
+//     quoted with single quotes, for example "'#'".
+//   - The number, for example "#,##0.00", contains the thousands separator ','
+//     if digits should be grouped by 3, and the decimal separator '.' followed by
+//     required digits '0' and optional digits '#'.
+//     Amounts are rounded to the total number of digits after the decimal point
+//     using [rounding half to even] (banker's rounding), and trailing zeros in
+//     the optional digits are removed.
+//
+// The number of digits after the decimal point in the pattern takes precedence
+// over the scale of the currency and over [Formatter.WithScale].
+// [Formatter.Parse] does not use the pattern.
+//
+// WithPattern returns an error if the pattern is not valid.
+//
+// [CLDR syntax]: https://unicode.org/reports/tr35/tr35-numbers.html#Number_Patterns
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (f Formatter) WithPattern(p string) (Formatter, error) {
+	pat, err := parsePattern(p)
+	if err != nil {
+		return Formatter{}, fmt.Errorf("parsing pattern %q: %w", p, err)
+	}
+	f.pat = pat
+	return f, nil
+}
+
+// WithNegativeStyle returns a copy of the formatter that displays negative
+// amounts using the given style instead of a leading minus sign.
+// The formatter itself is not modified.
+// A pattern set by [Formatter.WithPattern] takes precedence over the style,
+// since the pattern has its own negative subpattern.
+// [Formatter.Parse] accepts all styles regardless of this setting.
+//
+// WithNegativeStyle returns an error if the style is unknown.
+func (f Formatter) WithNegativeStyle(style NegativeStyle) (Formatter, error) {
+	if style < NegativeMinus || style > NegativeCreditDebit {
+		return Formatter{}, fmt.Errorf("setting negative style: unknown style %v", style)
+	}
+	f.neg = style
+	return f, nil
+}
+
+// Format returns a localized representation of the amount, for example
+// "1.234,56\u00a0€" for the "de-DE" locale.
+// The amount is rounded to the scale of its currency using
+// [rounding half to even] (banker's rounding) before formatting.
+// If the formatter has a display scale for the currency, set by
+// [Formatter.WithScale], the amount is rounded or padded to that scale instead.
+// If the formatter has a pattern, set by [Formatter.WithPattern], the amount
+// is displayed according to the pattern instead.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (f Formatter) Format(a Amount) string {
+	text := make([]byte, 0, 32)
+	text = f.AppendFormat(text, a)
+	return string(text)
+}
+
+// AppendFormat appends a localized representation of the amount to the byte
+// slice and returns the extended slice, like [Formatter.Format].
+// AppendFormat does not allocate memory if the slice has enough capacity,
+// which makes it suitable for formatting amounts in tight loops.
+func (f Formatter) AppendFormat(text []byte, a Amount) []byte {
+	if f.pat != nil {
+		return f.pat.append(text, f.loc, a)
+	}
+	if s, ok := f.scales[a.Curr()]; ok {
+		d := a.Decimal()
+		a = newAmountUnsafe(a.Curr(), d.Round(s).Pad(s))
+	} else {
+		a = a.RoundToCurr()
+	}
+	if f.neg == NegativeMinus {
+		return f.loc.appendAmount(text, a)
+	}
+
+	// Accounting conventions
+	neg := a.IsNeg()
+	switch f.neg {
+	case NegativeParens:
+		if !neg {
+			return f.loc.appendAmount(text, a)
+		}
+		text = append(text, '(')
+		text = f.loc.appendAmount(text, a.Abs())
+		return append(text, ')')
+	case NegativeTrailingMinus:
+		text = f.loc.appendAmount(text, a.Abs())
+		if neg {
+			text = append(text, '-')
+		}
+		return text
+	default:
+		text = f.loc.appendAmount(text, a.Abs())
+		switch {
+		case neg:
+			text = append(text, nbsp+"CR"...)
+		case a.IsPos():
+			text = append(text, nbsp+"DR"...)
+		}
+		return text
+	}
+}
+
+// locale represents the conventions for displaying monetary amounts
+// in a particular language and region.
+type locale struct {
+	point   string              // decimal separator
+	group   string              // thousands separator
+	prefix  bool                // currency symbol precedes the number
+	spaced  bool                // currency symbol is separated from the number by a space
+	symbols map[Currency]string // locale-specific currency symbols
+}
+
+const (
+	nbsp  = "\u00a0" // no-break space
+	nnbsp = "\u202f" // narrow no-break space
+)
+
+// Parse converts a localized string to a (possibly rounded) amount.
+// Besides the representations returned by [Formatter.Format], it accepts
+// common variations found in bank statements and spreadsheets:
+//   - a currency symbol or code before or after the number,
+//     for example "$1,234.56", "1.234,56 EUR", or "USD 12.30";
+//   - a leading or trailing minus or plus sign, or parentheses around
+//     negative amounts, for example "-$5.00", "45.00-", or "(45.00)";
+//   - a "CR" suffix for negative amounts or a "DR" suffix for positive
+//     amounts, for example "45.00 CR", see [NegativeCreditDebit];
+//   - dots, commas, spaces, and apostrophes as thousands separators.
+//
+// If the string contains both dots and commas, the one that occurs last is
+// the decimal separator.
+// If it contains only one of them, the separator is the decimal separator
+// if it is the decimal separator of the locale.
+// The thousands separator of the locale is never treated as a decimal
+// separator, so "1,2345" is rejected in "en" rather than parsed as 1.2345.
+// Other separators are decimal separators unless they occur more than once
+// or are followed by exactly 3 digits.
+//
+// Currency symbols are resolved using the conventions of the locale,
+// for example "$" is [USD] in "en-US" and [CAD] in "en-CA".
+// If the string does not contain a currency symbol or code, curr is used.
+// Pass [XXX] as curr if the currency is not known in advance.
+// If the result has fewer digits after the decimal point than the scale of
+// the currency, it will be zero-padded to the right.
+//
+// Parse returns an error if:
+//   - the string is not a valid localized amount;
+//   - the currency symbol is unknown or does not match curr;
+//   - the string does not contain a currency and curr is [XXX].
+func (f Formatter) Parse(text string, curr Currency) (Amount, error) {
+	a, err := f.parse(text, curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing %q: %w", text, err)
+	}
+	return a, nil
+}
+
+func (f Formatter) parse(text string, curr Currency) (Amount, error) {
+	s := strings.TrimSpace(text)
+
+	// Parentheses
+	var neg, signed bool
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = strings.TrimSpace(s[1 : len(s)-1])
+		neg, signed = true, true
+	}
+
+	// Credit and debit suffixes
+	if n := len(s); n >= 2 && (n == 2 || !isLetter(s[n-3])) {
+		if mark := strings.ToUpper(s[n-2:]); mark == "CR" || mark == "DR" {
+			if signed {
+				return Amount{}, fmt.Errorf("multiple signs")
+			}
+			s = strings.TrimSpace(s[:n-2])
+			neg, signed = mark == "CR", true
+		}
+	}
+
+	// Number
+	first := strings.IndexFunc(s, isDigit)
+	if first < 0 {
+		return Amount{}, fmt.Errorf("missing digits")
+	}
+	last := strings.LastIndexFunc(s, isDigit)
+	prefix, num, suffix := s[:first], s[first:last+1], s[last+1:]
+
+	// Arithmetic sign, leading or trailing
+	for _, part := range []*string{&prefix, &suffix} {
+		for _, sign := range []string{"-", "−", "+"} {
+			if before, after, ok := strings.Cut(*part, sign); ok {
+				if signed {
+					return Amount{}, fmt.Errorf("multiple signs")
+				}
+				*part = before + after
+				neg, signed = sign != "+", true
+				break
+			}
+		}
+	}
+
+	// Currency
+	prefix, suffix = strings.TrimSpace(prefix), strings.TrimSpace(suffix)
+	if prefix != "" && suffix != "" {
+		return Amount{}, fmt.Errorf("unexpected %q after the number", suffix)
+	}
+	m, err := f.loc.parseCurr(prefix+suffix, curr)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	// Decimal
+	norm, err := f.loc.normalize(num)
+	if err != nil {
+		return Amount{}, &InvalidAmountError{Input: num, Err: err}
+	}
+	d, err := decimal.ParseExact(norm, m.Scale())
+	if err != nil {
+		return Amount{}, &InvalidAmountError{Input: num, Err: decimalErr(err)}
+	}
+	if neg {
+		d = d.Neg()
+	}
+
+	// Amount
+	return newAmountSafe(m, d)
+}
+
+// parseCurr converts a currency symbol or code to currency.
+// If the symbol is empty, curr is returned.
+// If the symbol is used for several currencies, curr is preferred.
+func (l locale) parseCurr(sym string, curr Currency) (Currency, error) {
+	if sym == "" {
+		if curr == XXX {
+			return XXX, fmt.Errorf("missing currency")
+		}
+		return curr, nil
+	}
+	c, ok := l.lookupSymbol(sym, curr)
+	if !ok {
+		return XXX, &UnknownCurrencyError{Code: sym}
+	}
+	if curr != XXX && c != curr {
+		return XXX, fmt.Errorf("%v and %v: %w", c, curr, ErrCurrencyMismatch)
+	}
+	return c, nil
+}
+
+// lookupSymbol returns the currency denoted by a symbol or a code.
+// If the symbol is used for several currencies, curr is preferred.
+func (l locale) lookupSymbol(sym string, curr Currency) (Currency, bool) {
+	// Code
+	if len(sym) == 3 {
+		if c, err := ParseCurr(strings.ToUpper(sym)); err == nil {
+			return c, true
+		}
+	}
+
+	// Preferred currency
+	if curr != XXX {
+		if sym == l.symbol(curr) || sym == symbolLookup[curr] {
+			return curr, true
+		}
+		for _, loc := range localeLookup {
+			if loc.symbols[curr] == sym {
+				return curr, true
+			}
+		}
+	}
+
+	// Locale-specific and English symbols
+	for c, s := range l.symbols {
+		if s == sym {
+			return c, true
+		}
+	}
+	for c, s := range symbolLookup {
+		if s == sym && l.symbol(Currency(c)) == sym { //nolint:gosec
+			return Currency(c), true //nolint:gosec
+		}
+	}
+	return XXX, false
+}
+
+// normalize converts a localized number to a form accepted by [decimal.Parse].
+func (l locale) normalize(num string) (string, error) {
+	// Decimal separator
+	var point rune
+	dot, comma := strings.LastIndexByte(num, '.'), strings.LastIndexByte(num, ',')
+	switch {
+	case dot >= 0 && comma >= 0:
+		point = '.'
+		if comma > dot {
+			point = ','
+		}
+	case dot >= 0 || comma >= 0:
+		sep, pos := byte('.'), dot
+		if comma >= 0 {
+			sep, pos = ',', comma
+		}
+		switch {
+		case l.point == string(sep):
+			point = rune(sep)
+		case strings.Count(num, string(sep)) > 1 || l.group == string(sep):
+			// Group separator of the locale, validated below
+		case len(num)-pos-1 != 3:
+			point = rune(sep)
+		}
+	}
+
+	// Digits
+	var sb strings.Builder
+	prev := rune(0)
+	for i, r := range num {
+		switch {
+		case isDigit(r):
+			sb.WriteRune(r)
+		case r == point:
+			if !isDigit(prev) {
+				return "", fmt.Errorf("unexpected %q", r)
+			}
+			sb.WriteByte('.')
+		case r == '.' || r == ',' || r == '\'' || r == '’' || unicode.IsSpace(r):
+			// Thousands separator must be followed by exactly 3 digits
+			rest := num[i+utf8.RuneLen(r):]
+			if !isDigit(prev) || len(rest) < 3 || strings.IndexFunc(rest[:3], isNotDigit) >= 0 ||
+				len(rest) > 3 && isDigit(rune(rest[3])) {
+				return "", fmt.Errorf("unexpected %q", r)
+			}
+		default:
+			return "", fmt.Errorf("unexpected %q", r)
+		}
+		prev = r
+	}
+	return sb.String(), nil
+}
+
+// isDigit returns true if the rune is an ASCII digit.
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func isNotDigit(r rune) bool {
+	return !isDigit(r)
+}
+
+// lookupLocale returns the conventions for the given language tag.
+// If there is no entry for the language and region of the tag, the entry for
+// the language is used.
+// If there is no entry for the language either, the English locale is used.
+func lookupLocale(tag language.Tag) locale {
+	base, _ := tag.Base()
+	if region, conf := tag.Region(); conf == language.Exact {
+		if loc, ok := localeLookup[base.String()+"-"+region.String()]; ok {
+			return loc
+		}
+	}
+	if loc, ok := localeLookup[base.String()]; ok {
+		return loc
+	}
+	return localeLookup["en"]
+}
+
+// symbol returns the currency symbol used in the locale.
+func (l locale) symbol(c Currency) string {
+	if s, ok := l.symbols[c]; ok {
+		return s
+	}
+	if s := symbolLookup[c]; s != "" {
+		return s
+	}
+	return c.Code()
+}
+
+// appendAmount appends a localized representation of the amount to the byte slice.
+// The amount is displayed with its actual scale, the caller is responsible for
+// rounding or trimming it beforehand.
+func (l locale) appendAmount(text []byte, a Amount) []byte {
+	d := a.Decimal()
+	sym := l.symbol(a.Curr())
+
+	// Arithmetic sign
+	if d.IsNeg() {
+		text = append(text, '-')
+		d = d.Neg()
+	}
+
+	// Currency symbol and number
+	if l.prefix {
+		text = append(text, sym...)
+		if l.spaced || isLetter(sym[len(sym)-1]) {
+			text = append(text, nbsp...)
+		}
+		return appendGrouped(text, d, l.group, l.point)
+	}
+	text = appendGrouped(text, d, l.group, l.point)
+	if l.spaced {
+		text = append(text, nbsp...)
+	}
+	return append(text, sym...)
+}
+
+// isLetter returns true if the byte is an ASCII letter.
+func isLetter(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
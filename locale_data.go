@@ -0,0 +1,160 @@
+// Code generated by scripts/currency/codegen.go. DO NOT EDIT.
+// Any changes made to this file will be overwritten the next time it is generated.
+
+package money
+
+import "math"
+
+// localeLookup contains the conventions defined by the [CLDR] for the most
+// widely used locales.
+// Locales are identified either by language or by language and region.
+//
+// [CLDR]: https://cldr.unicode.org
+var localeLookup = map[string]locale{
+	"en":    {point: ".", group: ",", prefix: true, spaced: false},
+	"en-AU": {point: ".", group: ",", prefix: true, spaced: false, symbols: map[Currency]string{AUD: "$", USD: "US$"}},
+	"en-CA": {point: ".", group: ",", prefix: true, spaced: false, symbols: map[Currency]string{CAD: "$", USD: "US$"}},
+	"en-NZ": {point: ".", group: ",", prefix: true, spaced: false, symbols: map[Currency]string{NZD: "$", USD: "US$"}},
+	"de":    {point: ",", group: ".", prefix: false, spaced: true},
+	"de-AT": {point: ",", group: "\u00a0", prefix: true, spaced: true},
+	"de-CH": {point: ".", group: "’", prefix: true, spaced: true},
+	"es":    {point: ",", group: ".", prefix: false, spaced: true},
+	"es-MX": {point: ".", group: ",", prefix: true, spaced: false, symbols: map[Currency]string{MXN: "$", USD: "USD"}},
+	"fr":    {point: ",", group: "\u202f", prefix: false, spaced: true},
+	"fr-CA": {point: ",", group: "\u00a0", prefix: false, spaced: true, symbols: map[Currency]string{CAD: "$", USD: "$\u00a0US"}},
+	"fr-CH": {point: ",", group: "\u202f", prefix: false, spaced: true},
+	"it":    {point: ",", group: ".", prefix: false, spaced: true},
+	"ja":    {point: ".", group: ",", prefix: true, spaced: false, symbols: map[Currency]string{CNY: "元", JPY: "￥"}},
+	"ko":    {point: ".", group: ",", prefix: true, spaced: false},
+	"nl":    {point: ",", group: ".", prefix: true, spaced: true},
+	"pl":    {point: ",", group: "\u00a0", prefix: false, spaced: true, symbols: map[Currency]string{PLN: "zł"}},
+	"pt":    {point: ",", group: ".", prefix: true, spaced: true},
+	"pt-PT": {point: ",", group: "\u00a0", prefix: false, spaced: true},
+	"ru":    {point: ",", group: "\u00a0", prefix: false, spaced: true, symbols: map[Currency]string{RUB: "₽"}},
+	"sv":    {point: ",", group: "\u00a0", prefix: false, spaced: true, symbols: map[Currency]string{SEK: "kr"}},
+	"zh":    {point: ".", group: ",", prefix: true, spaced: false, symbols: map[Currency]string{CNY: "¥", JPY: "JP¥"}},
+}
+
+// symbolLookup contains the currency symbols defined by the [CLDR] for
+// the English locale.
+// They are used unless the locale defines its own symbol.
+// Currencies without a symbol have an empty entry and are displayed using
+// their 3-letter code.
+//
+// [CLDR]: https://cldr.unicode.org
+var symbolLookup = [math.MaxUint8 + 1]string{
+	AUD: "A$",
+	BRL: "R$",
+	CAD: "CA$",
+	CNY: "CN¥",
+	EUR: "€",
+	GBP: "£",
+	HKD: "HK$",
+	ILS: "₪",
+	INR: "₹",
+	JPY: "¥",
+	KRW: "₩",
+	MXN: "MX$",
+	NZD: "NZ$",
+	PHP: "₱",
+	TWD: "NT$",
+	USD: "$",
+	VND: "₫",
+	XAF: "FCFA",
+	XCD: "EC$",
+	XOF: "F\u202fCFA",
+	XPF: "CFPF",
+}
+
+// narrowLookup contains the narrow currency symbols defined by the [CLDR] for
+// the English locale, such as "$" for the Canadian Dollar.
+// They are used where the currency is clear from the context.
+// Currencies without a narrow symbol have an empty entry and are displayed
+// using their regular symbol.
+//
+// [CLDR]: https://cldr.unicode.org
+var narrowLookup = [math.MaxUint8 + 1]string{
+	AMD: "֏",
+	AOA: "Kz",
+	ARS: "$",
+	AUD: "$",
+	AZN: "₼",
+	BAM: "KM",
+	BBD: "$",
+	BDT: "৳",
+	BMD: "$",
+	BND: "$",
+	BOB: "Bs",
+	BSD: "$",
+	BWP: "P",
+	BYN: "р.",
+	BZD: "$",
+	CAD: "$",
+	CLP: "$",
+	CNY: "¥",
+	COP: "$",
+	CRC: "₡",
+	CUP: "$",
+	CZK: "Kč",
+	DKK: "kr",
+	DOP: "$",
+	EGP: "E£",
+	FJD: "$",
+	FKP: "£",
+	GEL: "₾",
+	GHS: "GH₵",
+	GIP: "£",
+	GNF: "FG",
+	GTQ: "Q",
+	GYD: "$",
+	HKD: "$",
+	HNL: "L",
+	HUF: "Ft",
+	IDR: "Rp",
+	ISK: "kr",
+	JMD: "$",
+	KHR: "៛",
+	KMF: "CF",
+	KPW: "₩",
+	KYD: "$",
+	KZT: "₸",
+	LAK: "₭",
+	LBP: "L£",
+	LKR: "Rs",
+	LRD: "$",
+	MGA: "Ar",
+	MMK: "K",
+	MNT: "₮",
+	MUR: "Rs",
+	MXN: "$",
+	MYR: "RM",
+	NAD: "$",
+	NGN: "₦",
+	NIO: "C$",
+	NOK: "kr",
+	NPR: "Rs",
+	NZD: "$",
+	PKR: "Rs",
+	PLN: "zł",
+	PYG: "₲",
+	RUB: "₽",
+	RWF: "RF",
+	SBD: "$",
+	SEK: "kr",
+	SGD: "$",
+	SHP: "£",
+	SRD: "$",
+	SSP: "£",
+	STN: "Db",
+	SYP: "£",
+	THB: "฿",
+	TOP: "T$",
+	TRY: "₺",
+	TTD: "$",
+	TWD: "$",
+	UAH: "₴",
+	UYU: "$",
+	XCD: "$",
+	ZAR: "R",
+	ZMW: "ZK",
+}
@@ -0,0 +1,376 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+)
+
+func TestLocale_AppendAmount(t *testing.T) {
+	tests := []struct {
+		tag, m, d, want string
+	}{
+		// English
+		{"en", "USD", "1234.56", "$1,234.56"},
+		{"en", "USD", "-1234.56", "-$1,234.56"},
+		{"en", "EUR", "0.50", "€0.50"},
+		{"en", "CHF", "1234.56", "CHF\u00a01,234.56"},
+		{"en", "JPY", "1234567", "¥1,234,567"},
+		{"en-US", "CAD", "5.00", "CA$5.00"},
+		{"en-CA", "CAD", "5.00", "$5.00"},
+		{"en-CA", "USD", "5.00", "US$5.00"},
+		{"en-GB", "GBP", "5.00", "£5.00"},
+
+		// German
+		{"de", "EUR", "1234.56", "1.234,56\u00a0€"},
+		{"de-DE", "EUR", "-1234.56", "-1.234,56\u00a0€"},
+		{"de-AT", "EUR", "1234.56", "€\u00a01\u00a0234,56"},
+		{"de-CH", "CHF", "1234.56", "CHF\u00a01’234.56"},
+
+		// Other languages
+		{"fr", "EUR", "1234567.89", "1\u202f234\u202f567,89\u00a0€"},
+		{"fr-CA", "CAD", "5.00", "5,00\u00a0$"},
+		{"fr-CA", "USD", "5.00", "5,00\u00a0$\u00a0US"},
+		{"nl", "EUR", "1234.56", "€\u00a01.234,56"},
+		{"pt-BR", "BRL", "1234.56", "R$\u00a01.234,56"},
+		{"ja", "JPY", "1234", "￥1,234"},
+		{"zh", "CNY", "1234.56", "¥1,234.56"},
+		{"ru", "RUB", "1234.56", "1\u00a0234,56\u00a0₽"},
+		{"sv", "SEK", "1234.56", "1\u00a0234,56\u00a0kr"},
+
+		// Fallback to English
+		{"und", "USD", "1234.56", "$1,234.56"},
+		{"tlh", "USD", "1234.56", "$1,234.56"},
+	}
+	for _, tt := range tests {
+		tag := language.MustParse(tt.tag)
+		a := MustParseAmount(tt.m, tt.d)
+		got := string(lookupLocale(tag).appendAmount(nil, a))
+		if got != tt.want {
+			t.Errorf("lookupLocale(%q).appendAmount(%q) = %q, want %q", tag, a, got, tt.want)
+		}
+	}
+}
+
+func TestNewFormatter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"en", "en-US", "de-DE", "fr-CA", "zh-Hant-TW", "und", "tlh"}
+		for _, tt := range tests {
+			_, err := NewFormatter(tt)
+			if err != nil {
+				t.Errorf("NewFormatter(%q) failed: %v", tt, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "e", "en_US!", "de-DE-x"}
+		for _, tt := range tests {
+			_, err := NewFormatter(tt)
+			if err == nil {
+				t.Errorf("NewFormatter(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestFormatter_Format(t *testing.T) {
+	tests := []struct {
+		tag, m, d, want string
+	}{
+		{"en", "USD", "0", "$0.00"},
+		{"en", "USD", "1234.5", "$1,234.50"},
+		{"en", "USD", "1234.565", "$1,234.56"},
+		{"en", "USD", "-1234.575", "-$1,234.58"},
+		{"en", "JPY", "1234.5", "¥1,234"},
+		{"en", "OMR", "1234.5", "OMR\u00a01,234.500"},
+		{"de-DE", "EUR", "1234.56", "1.234,56\u00a0€"},
+		{"de-DE", "USD", "-1234.56", "-1.234,56\u00a0$"},
+		{"fr-FR", "EUR", "1234.56", "1\u202f234,56\u00a0€"},
+		{"ja-JP", "JPY", "1234", "￥1,234"},
+		{"tlh", "EUR", "1234.56", "€1,234.56"},
+	}
+	for _, tt := range tests {
+		f := MustNewFormatter(tt.tag)
+		a := MustParseAmount(tt.m, tt.d)
+		got := f.Format(a)
+		if got != tt.want {
+			t.Errorf("NewFormatter(%q).Format(%q) = %q, want %q", tt.tag, a, got, tt.want)
+		}
+	}
+}
+
+func TestFormatter_AppendFormat(t *testing.T) {
+	tests := []struct {
+		tag, m, d, prefix, want string
+	}{
+		{"en", "USD", "1234.5", "", "$1,234.50"},
+		{"en", "USD", "0.05", "total: ", "total: $0.05"},
+		{"de-DE", "EUR", "-1234.565", "[", "[-1.234,56\u00a0€"},
+	}
+	for _, tt := range tests {
+		f := MustNewFormatter(tt.tag)
+		a := MustParseAmount(tt.m, tt.d)
+		got := f.AppendFormat([]byte(tt.prefix), a)
+		if string(got) != tt.want {
+			t.Errorf("NewFormatter(%q).AppendFormat(%q, %q) = %q, want %q", tt.tag, tt.prefix, a, got, tt.want)
+		}
+	}
+}
+
+func TestFormatter_WithScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			tag, m    string
+			scale     int
+			d, want   string
+			otherWant string
+		}{
+			{"en", "JPY", 2, "1234.567", "¥1,234.57", "$1,234.57"},
+			{"en", "JPY", 0, "1234.5", "¥1,234", "$1,234.50"},
+			{"en", "USD", 0, "1234.5", "$1,234", "$1,234"},
+			{"en", "USD", 3, "3.4599", "$3.460", "$3.460"},
+			{"de-DE", "EUR", 4, "-1234.5", "-1.234,5000\u00a0€", "-1.234,50\u00a0$"},
+		}
+		for _, tt := range tests {
+			base := MustNewFormatter(tt.tag)
+			m := MustParseCurr(tt.m)
+			f, err := base.WithScale(m, tt.scale)
+			if err != nil {
+				t.Errorf("WithScale(%v, %v) failed: %v", m, tt.scale, err)
+				continue
+			}
+			a := MustParseAmount(tt.m, tt.d)
+			if got := f.Format(a); got != tt.want {
+				t.Errorf("WithScale(%v, %v).Format(%q) = %q, want %q", m, tt.scale, a, got, tt.want)
+			}
+			// Other currencies use their own scale
+			b := MustParseAmount("USD", tt.d)
+			if got := f.Format(b); got != tt.otherWant {
+				t.Errorf("WithScale(%v, %v).Format(%q) = %q, want %q", m, tt.scale, b, got, tt.otherWant)
+			}
+			// The original formatter is not modified
+			if got, want := base.Format(a), MustNewFormatter(tt.tag).Format(a); got != want {
+				t.Errorf("Format(%q) = %q, want %q", a, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		f := MustNewFormatter("en")
+		for _, scale := range []int{-1, decimal.MaxScale + 1} {
+			_, err := f.WithScale(USD, scale)
+			if err == nil {
+				t.Errorf("WithScale(%v, %v) did not fail", USD, scale)
+			}
+		}
+	})
+}
+
+func TestFormatter_WithNegativeStyle(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			tag     string
+			style   NegativeStyle
+			m, d    string
+			want    string
+			wantPos string
+		}{
+			{"en", NegativeMinus, "USD", "-1234.56", "-$1,234.56", "$1,234.56"},
+			{"en", NegativeParens, "USD", "-1234.56", "($1,234.56)", "$1,234.56"},
+			{"en", NegativeTrailingMinus, "USD", "-1234.56", "$1,234.56-", "$1,234.56"},
+			{"en", NegativeCreditDebit, "USD", "-1234.56", "$1,234.56\u00a0CR", "$1,234.56\u00a0DR"},
+			{"en", NegativeParens, "USD", "-0.004", "$0.00", "$0.00"},
+			{"en", NegativeCreditDebit, "USD", "0", "$0.00", "$0.00"},
+			{"de-DE", NegativeParens, "EUR", "-1234.56", "(1.234,56\u00a0€)", "1.234,56\u00a0€"},
+			{"de-DE", NegativeTrailingMinus, "EUR", "-1234.56", "1.234,56\u00a0€-", "1.234,56\u00a0€"},
+		}
+		for _, tt := range tests {
+			base := MustNewFormatter(tt.tag)
+			f, err := base.WithNegativeStyle(tt.style)
+			if err != nil {
+				t.Errorf("WithNegativeStyle(%v) failed: %v", tt.style, err)
+				continue
+			}
+			a := MustParseAmount(tt.m, tt.d)
+			if got := f.Format(a); got != tt.want {
+				t.Errorf("WithNegativeStyle(%v).Format(%q) = %q, want %q", tt.style, a, got, tt.want)
+			}
+			if got := f.Format(a.Neg()); got != tt.wantPos {
+				t.Errorf("WithNegativeStyle(%v).Format(%q) = %q, want %q", tt.style, a.Neg(), got, tt.wantPos)
+			}
+			// Formatted amounts can be parsed back
+			if got, err := f.Parse(tt.want, XXX); err != nil || got != a.RoundToCurr() {
+				t.Errorf("WithNegativeStyle(%v).Parse(%q) = %q, %v, want %q", tt.style, tt.want, got, err, a.RoundToCurr())
+			}
+			// The original formatter is not modified
+			if got, want := base.Format(a), MustNewFormatter(tt.tag).Format(a); got != want {
+				t.Errorf("Format(%q) = %q, want %q", a, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		f := MustNewFormatter("en")
+		for _, style := range []NegativeStyle{-1, NegativeCreditDebit + 1} {
+			_, err := f.WithNegativeStyle(style)
+			if err == nil {
+				t.Errorf("WithNegativeStyle(%v) did not fail", style)
+			}
+		}
+	})
+}
+
+func TestNegativeStyle_String(t *testing.T) {
+	tests := []struct {
+		s    NegativeStyle
+		want string
+	}{
+		{NegativeMinus, "NegativeMinus"},
+		{NegativeParens, "NegativeParens"},
+		{NegativeTrailingMinus, "NegativeTrailingMinus"},
+		{NegativeCreditDebit, "NegativeCreditDebit"},
+		{NegativeStyle(9), "NegativeStyle(9)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("NegativeStyle(%d).String() = %q, want %q", int8(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestFormatter_Parse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			tag, text string
+			curr      Currency
+			wantCurr  string
+			wantAmt   string
+		}{
+			// Symbols and codes
+			{"en", "$1,234.56", XXX, "USD", "1234.56"},
+			{"en", "1.234,56 EUR", XXX, "EUR", "1234.56"},
+			{"en", "USD 12.30", XXX, "USD", "12.30"},
+			{"en", "usd 12.3", XXX, "USD", "12.30"},
+			{"en", "€0.50", XXX, "EUR", "0.50"},
+			{"en", "¥1,234", XXX, "JPY", "1234"},
+			{"en", "CHF\u00a01,234.56", XXX, "CHF", "1234.56"},
+			{"en", "CA$5", XXX, "CAD", "5.00"},
+			{"en-CA", "$5", XXX, "CAD", "5.00"},
+			{"en-CA", "US$5", XXX, "USD", "5.00"},
+			{"en", "$5", CAD, "CAD", "5.00"},
+			{"zh", "¥5", XXX, "CNY", "5.00"},
+			{"sv", "5 kr", XXX, "SEK", "5.00"},
+			{"en", "12.30", GBP, "GBP", "12.30"},
+
+			// Signs
+			{"en", "(45.00)", USD, "USD", "-45.00"},
+			{"en", "($45.00)", XXX, "USD", "-45.00"},
+			{"en", "-$5.00", XXX, "USD", "-5.00"},
+			{"en", "$-5.00", XXX, "USD", "-5.00"},
+			{"en", "−5.00 EUR", XXX, "EUR", "-5.00"},
+			{"en", "+5.00 EUR", XXX, "EUR", "5.00"},
+			{"en", "1234.56-", USD, "USD", "-1234.56"},
+			{"en", "$1,234.56-", XXX, "USD", "-1234.56"},
+			{"en", "1234.56- USD", XXX, "USD", "-1234.56"},
+			{"de", "1.234,56 €-", XXX, "EUR", "-1234.56"},
+			{"en", "5.00+", USD, "USD", "5.00"},
+			{"en", "1,234.56 CR", USD, "USD", "-1234.56"},
+			{"en", "1,234.56CR", USD, "USD", "-1234.56"},
+			{"en", "1,234.56 cr", USD, "USD", "-1234.56"},
+			{"en", "$1,234.56 DR", XXX, "USD", "1234.56"},
+			{"en", "1,234.56 USD CR", XXX, "USD", "-1234.56"},
+			{"en", "100 XDR", XXX, "XDR", "100"},
+
+			// Separators
+			{"en", "1,234", USD, "USD", "1234.00"},
+			{"en", "1.234", USD, "USD", "1.234"},
+			{"en", "1,234,567.891", USD, "USD", "1234567.891"},
+			{"fr", "12.30", EUR, "EUR", "12.30"},
+			{"fr", "1.234", EUR, "EUR", "1234.00"},
+			{"de", "1.234", EUR, "EUR", "1234.00"},
+			{"de", "1,234", EUR, "EUR", "1.234"},
+			{"de", "1.234,56\u00a0€", XXX, "EUR", "1234.56"},
+			{"de-CH", "CHF 1’234.56", XXX, "CHF", "1234.56"},
+			{"de-CH", "CHF 1'234.56", XXX, "CHF", "1234.56"},
+			{"fr", "1\u202f234\u202f567,89\u00a0€", XXX, "EUR", "1234567.89"},
+			{"fr", "1 234 567,89 €", XXX, "EUR", "1234567.89"},
+		}
+		for _, tt := range tests {
+			f := MustNewFormatter(tt.tag)
+			got, err := f.Parse(tt.text, tt.curr)
+			if err != nil {
+				t.Errorf("NewFormatter(%q).Parse(%q, %v) failed: %v", tt.tag, tt.text, tt.curr, err)
+				continue
+			}
+			want := MustParseAmount(tt.wantCurr, tt.wantAmt)
+			if got != want {
+				t.Errorf("NewFormatter(%q).Parse(%q, %v) = %q, want %q", tt.tag, tt.text, tt.curr, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			tag, text string
+			curr      Currency
+		}{
+			"empty":         {"en", "", USD},
+			"no digits":     {"en", "$", XXX},
+			"no currency":   {"en", "12.30", XXX},
+			"unknown curr":  {"en", "12.30 ABC", XXX},
+			"unknown sym":   {"en", "kr 12.30", XXX},
+			"mismatch 1":    {"en", "€12.30", USD},
+			"mismatch 2":    {"en", "EUR 12.30", USD},
+			"both sides":    {"en", "$12.30 USD", XXX},
+			"double sign 1": {"en", "(-12.30)", USD},
+			"double sign 2": {"en", "--12.30", USD},
+			"double sign 3": {"en", "-12.30-", USD},
+			"double sign 4": {"en", "(12.30)-", USD},
+			"double sign 5": {"en", "(12.30) CR", USD},
+			"double sign 6": {"en", "-12.30 CR", USD},
+			"double sign 7": {"en", "12.30 CR DR", USD},
+			"only mark":     {"en", "CR", USD},
+			"group 1":       {"en", "1,23,456.00", USD},
+			"group 2":       {"en", "1,2345.00", USD},
+			"group 3":       {"en", "1.234.56", USD},
+			"group 4":       {"en", "12,30", USD},
+			"group 5":       {"en", "$1,2345", XXX},
+			"group 6":       {"de", "1.2345", EUR},
+			"point 1":       {"en", "1.2.3,4", USD},
+			"garbage":       {"en", "12a30", USD},
+			"overflow":      {"en", "99999999999999999999", USD},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				f := MustNewFormatter(tt.tag)
+				_, err := f.Parse(tt.text, tt.curr)
+				if err == nil {
+					t.Errorf("NewFormatter(%q).Parse(%q, %v) did not fail", tt.tag, tt.text, tt.curr)
+				}
+			})
+		}
+	})
+}
+
+var textSink []byte
+
+func BenchmarkFormatter_Format(b *testing.B) {
+	f := MustNewFormatter("de-DE")
+	a := MustParseAmount("EUR", "-1234567.89")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stringSink = f.Format(a)
+	}
+}
+
+func BenchmarkFormatter_AppendFormat(b *testing.B) {
+	f := MustNewFormatter("de-DE")
+	a := MustParseAmount("EUR", "-1234567.89")
+	text := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		textSink = f.AppendFormat(text[:0], a)
+	}
+}
@@ -0,0 +1,39 @@
+package moneytest_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/moneytest"
+)
+
+func ExampleRandAmount() {
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 3 {
+		fmt.Println(moneytest.RandAmount(r, money.USD))
+	}
+	// Output:
+	// USD 6772044116.49
+	// USD 7750.30
+	// USD 3.77
+}
+
+func ExampleNewRandom() {
+	r := rand.New(rand.NewPCG(1, 2))
+	limit := money.MustParseAmount("USD", "100.00")
+	for range 3 {
+		fmt.Println(moneytest.NewRandom(r, "USD", limit))
+	}
+	// Output:
+	// USD -76.94 <nil>
+	// USD -78.45 <nil>
+	// USD -23.42 <nil>
+}
+
+func ExampleRandCurr() {
+	r := rand.New(rand.NewPCG(1, 2))
+	a := moneytest.RandAmount(r, moneytest.RandCurr(r))
+	fmt.Println(a.Curr().Scale() == a.Scale())
+	// Output: true
+}
@@ -0,0 +1,187 @@
+/*
+Package moneytest implements utilities for property-based testing of code
+that works with amounts of money.
+
+Generators produce random currencies and amounts from a [rand.Rand], so that
+a failing case can be reproduced from its seed:
+  - [RandCurr] returns a random currency.
+  - [RandAmount] returns a random amount in a given currency.
+  - [NewRandom] returns a random amount within a given bound.
+
+Amounts also implement [quick.Generator], see [money.Amount.Generate].
+
+Assertions check the invariants that the money package itself guarantees,
+using the same exact arithmetic:
+  - [AssertSumPreserved] checks that parts sum up exactly to a total.
+  - [AssertAllocationComplete] checks that an allocation distributes a total
+    proportionally to the ratios without losing or creating minor units.
+
+[quick.Generator]: https://pkg.go.dev/testing/quick#Generator
+*/
+package moneytest
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+// randMaxPrec is the maximum number of digits in amounts returned by
+// RandAmount.
+// Since it is 4 digits less than [decimal.MaxPrec], up to 10,000 random
+// amounts can be added together without an overflow.
+const randMaxPrec = decimal.MaxPrec - 4
+
+// RandCurr returns a random currency, chosen uniformly from the currencies
+// defined by the ISO 4217 standard and by [money.RegisterCurr].
+// [money.XXX] is never returned.
+func RandCurr(r *rand.Rand) money.Currency {
+	currs := money.AllCurrs()[1:] // XXX always comes first
+	return currs[r.IntN(len(currs))]
+}
+
+// RandAmount returns a random amount in the given currency, with as many
+// digits after the decimal point as the scale of the currency.
+// The number of digits is chosen uniformly between 1 and 15, and then every
+// digit and the sign are chosen uniformly, so that small, large, negative and
+// zero amounts are all likely to be returned.
+// Up to 10,000 amounts returned by RandAmount can be added together without
+// an overflow.
+func RandAmount(r *rand.Rand, curr money.Currency) money.Amount {
+	prec := 1 + r.IntN(randMaxPrec)
+	var coef int64
+	for range prec {
+		coef = coef*10 + r.Int64N(10)
+	}
+	if r.IntN(2) == 0 {
+		coef = -coef
+	}
+	a, err := money.NewAmountFromDecimal(curr, decimal.MustNew(coef, curr.Scale()))
+	if err != nil {
+		panic(err) // RandAmount never exceeds the precision of amounts
+	}
+	return a
+}
+
+// NewRandom returns a random amount in the given currency, between -max and
+// max inclusive, with as many digits after the decimal point as the scale of
+// the currency.
+// The absolute value is chosen uniformly between zero and the absolute value of
+// max truncated to the scale of the currency, and then the sign is chosen
+// uniformly.
+// Unlike [RandAmount], NewRandom keeps amounts in a range that is meaningful
+// for the code under test, such as the limits of a payment.
+// See also method [money.Amount.Generate] for use with [quick.Check].
+//
+// NewRandom returns an error if:
+//   - the currency code is not valid;
+//   - max is denominated in a different currency.
+//
+// [quick.Check]: https://pkg.go.dev/testing/quick#Check
+func NewRandom(r *rand.Rand, curr string, max money.Amount) (money.Amount, error) { //nolint:revive
+	m, err := money.ParseCurr(curr)
+	if err != nil {
+		return money.Amount{}, fmt.Errorf("generating amount: %w", err)
+	}
+	if m != max.Curr() {
+		return money.Amount{}, fmt.Errorf("generating amount: [%v] and %v: %w", max, m, money.ErrCurrencyMismatch)
+	}
+	bound := max.Decimal().Abs().Trunc(m.Scale()).Coef()
+	if bound >= math.MaxInt64 {
+		bound = math.MaxInt64 - 1
+	}
+	coef := r.Int64N(int64(bound) + 1) //nolint:gosec
+	if r.IntN(2) == 0 {
+		coef = -coef
+	}
+	return money.NewAmountFromDecimal(m, decimal.MustNew(coef, m.Scale()))
+}
+
+// AssertSumPreserved checks that the parts are denominated in the currency
+// of the total and sum up exactly to the total.
+// It reports failures using [testing.TB.Errorf] and returns true if all
+// checks pass.
+func AssertSumPreserved(t testing.TB, total money.Amount, parts []money.Amount) bool {
+	t.Helper()
+	ok := true
+	sum := new(big.Rat)
+	for i, p := range parts {
+		if p.Curr() != total.Curr() {
+			t.Errorf("part %v = %v, want currency %v", i, p, total.Curr())
+			ok = false
+			continue
+		}
+		sum.Add(sum, rat(p.Decimal()))
+	}
+	if ok && sum.Cmp(rat(total.Decimal())) != 0 {
+		t.Errorf("sum of %v = %v, want %v", parts, sum.FloatString(total.Scale()), total)
+		ok = false
+	}
+	return ok
+}
+
+// AssertAllocationComplete checks that the parts are an allocation of the
+// total in the given ratios, such as the ones returned by [money.Amount.Allocate]:
+//   - there is exactly one part for every ratio;
+//   - the parts sum up exactly to the total, see [AssertSumPreserved];
+//   - no part has more digits after the decimal point than the total;
+//   - every part differs from its exact proportional share by at most
+//     one unit in the last place of the total;
+//   - parts with zero ratios are zero.
+//
+// It reports failures using [testing.TB.Errorf] and returns true if all
+// checks pass.
+func AssertAllocationComplete(t testing.TB, total money.Amount, ratios []int, parts []money.Amount) bool {
+	t.Helper()
+	if len(parts) != len(ratios) {
+		t.Errorf("allocation of %v in ratios %v has %v parts, want %v", total, ratios, len(parts), len(ratios))
+		return false
+	}
+	ok := AssertSumPreserved(t, total, parts)
+	sum := new(big.Rat)
+	for _, q := range ratios {
+		sum.Add(sum, big.NewRat(int64(q), 1))
+	}
+	if sum.Sign() == 0 {
+		t.Errorf("allocation of %v in ratios %v has no positive ratios", total, ratios)
+		return false
+	}
+	ulp := rat(decimal.MustNew(1, total.Scale()))
+	for i, p := range parts {
+		if p.Curr() != total.Curr() {
+			continue // already reported by AssertSumPreserved
+		}
+		if p.Trim(total.Scale()).Scale() > total.Scale() {
+			t.Errorf("part %v = %v, has more digits after the decimal point than %v", i, p, total)
+			ok = false
+		}
+		if ratios[i] == 0 && !p.IsZero() {
+			t.Errorf("part %v = %v, want zero for zero ratio", i, p)
+			ok = false
+		}
+		// |part - total * ratio / sum| <= ulp
+		share := new(big.Rat).Mul(rat(total.Decimal()), big.NewRat(int64(ratios[i]), 1))
+		share.Quo(share, sum)
+		diff := new(big.Rat).Sub(rat(p.Decimal()), share)
+		if diff.Abs(diff).Cmp(ulp) > 0 {
+			t.Errorf("part %v = %v, want within %v of %v", i, p, ulp.FloatString(total.Scale()), share.FloatString(total.Scale()+2))
+			ok = false
+		}
+	}
+	return ok
+}
+
+// rat converts a decimal to an exact rational number.
+func rat(d decimal.Decimal) *big.Rat {
+	num := new(big.Int).SetUint64(d.Coef())
+	if d.IsNeg() {
+		num.Neg(num)
+	}
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Scale())), nil)
+	return new(big.Rat).SetFrac(num, den)
+}
@@ -0,0 +1,221 @@
+package moneytest
+
+import (
+	"fmt"
+	randv1 "math/rand"
+	"math/rand/v2"
+	"testing"
+	"testing/quick"
+
+	"github.com/lunafinancialgroup/money"
+)
+
+// recorder is a testing.TB that records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRandCurr(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	seen := make(map[money.Currency]bool)
+	for range 10_000 {
+		c := RandCurr(r)
+		if c == money.XXX || c.Code() == "" {
+			t.Fatalf("RandCurr() = %v, want a defined currency", c)
+		}
+		seen[c] = true
+	}
+	if len(seen) < 100 {
+		t.Errorf("RandCurr() returned %v distinct currencies, want at least 100", len(seen))
+	}
+}
+
+func TestRandAmount(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	var neg, pos, zero int
+	for range 10_000 {
+		m := RandCurr(r)
+		a := RandAmount(r, m)
+		if a.Curr() != m {
+			t.Fatalf("RandAmount(%v) = %v, want currency %v", m, a, m)
+		}
+		if a.Scale() != m.Scale() {
+			t.Fatalf("RandAmount(%v) = %v, want scale %v", m, a, m.Scale())
+		}
+		if a.Decimal().Prec() > randMaxPrec {
+			t.Fatalf("RandAmount(%v) = %v, want at most %v digits", m, a, randMaxPrec)
+		}
+		switch {
+		case a.IsNeg():
+			neg++
+		case a.IsPos():
+			pos++
+		default:
+			zero++
+		}
+	}
+	if neg == 0 || pos == 0 || zero == 0 {
+		t.Errorf("RandAmount() returned %v negative, %v positive, %v zero amounts, want all kinds", neg, pos, zero)
+	}
+}
+
+func TestNewRandom(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, max string
+		}{
+			{"USD", "0.00"},
+			{"USD", "0.01"},
+			{"USD", "100.00"},
+			{"USD", "-100.00"},
+			{"USD", "12.345"},
+			{"JPY", "1000"},
+			{"USD", "99999999999999999.99"},
+		}
+		r := rand.New(rand.NewPCG(1, 2))
+		for _, tt := range tests {
+			hi := money.MustParseAmount(tt.curr, tt.max).Abs()
+			lo := hi.Neg()
+			for range 1_000 {
+				got, err := NewRandom(r, tt.curr, hi)
+				if err != nil {
+					t.Fatalf("NewRandom(%q, %v) failed: %v", tt.curr, hi, err)
+				}
+				if got.Curr().Code() != tt.curr || !got.SameScaleAsCurr() {
+					t.Fatalf("NewRandom(%q, %v) = %v, want scale of %v", tt.curr, hi, got, tt.curr)
+				}
+				if c, _ := got.Cmp(lo); c < 0 {
+					t.Fatalf("NewRandom(%q, %v) = %v, want at least %v", tt.curr, hi, got, lo)
+				}
+				if c, _ := got.Cmp(hi); c > 0 {
+					t.Fatalf("NewRandom(%q, %v) = %v, want at most %v", tt.curr, hi, got, hi)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			curr string
+			max  money.Amount
+		}{
+			{"UUU", money.MustParseAmount("USD", "1")},
+			{"EUR", money.MustParseAmount("USD", "1")},
+		}
+		r := rand.New(rand.NewPCG(1, 2))
+		for _, tt := range tests {
+			if _, err := NewRandom(r, tt.curr, tt.max); err == nil {
+				t.Errorf("NewRandom(%q, %v) did not fail", tt.curr, tt.max)
+			}
+		}
+	})
+}
+
+func TestAmount_Generate(t *testing.T) {
+	// Addition is commutative for amounts in the same currency.
+	f := func(a, b money.Amount) bool {
+		b, err := money.NewAmountFromDecimal(a.Curr(), b.Decimal())
+		if err != nil {
+			return true // scale of b exceeds the scale of the currency of a
+		}
+		x, err := a.Add(b)
+		if err != nil {
+			return false
+		}
+		y, err := b.Add(a)
+		if err != nil {
+			return false
+		}
+		return x == y
+	}
+	cfg := &quick.Config{Rand: randv1.New(randv1.NewSource(1))}
+	if err := quick.Check(f, cfg); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAssertSumPreserved(t *testing.T) {
+	usd := func(s string) money.Amount { return money.MustParseAmount("USD", s) }
+	tests := []struct {
+		total    money.Amount
+		parts    []money.Amount
+		wantErrs int
+	}{
+		{usd("0"), nil, 0},
+		{usd("1"), []money.Amount{usd("0.33"), usd("0.33"), usd("0.34")}, 0},
+		{usd("1"), []money.Amount{usd("0.500"), usd("0.5")}, 0},
+		{usd("1"), []money.Amount{usd("0.33"), usd("0.33"), usd("0.33")}, 1},
+		{usd("1"), []money.Amount{usd("0.50"), money.MustParseAmount("EUR", "0.50")}, 1},
+	}
+	for _, tt := range tests {
+		rec := &recorder{TB: t}
+		ok := AssertSumPreserved(rec, tt.total, tt.parts)
+		if len(rec.errors) != tt.wantErrs || ok != (tt.wantErrs == 0) {
+			t.Errorf("AssertSumPreserved(%v, %v) = %t, reported %q, want %v failures", tt.total, tt.parts, ok, rec.errors, tt.wantErrs)
+		}
+	}
+}
+
+func TestAssertAllocationComplete(t *testing.T) {
+	usd := func(s string) money.Amount { return money.MustParseAmount("USD", s) }
+	tests := []struct {
+		total    money.Amount
+		ratios   []int
+		parts    []money.Amount
+		wantErrs int
+	}{
+		{usd("1"), []int{1, 1, 1}, []money.Amount{usd("0.34"), usd("0.33"), usd("0.33")}, 0},
+		{usd("1"), []int{1, 0}, []money.Amount{usd("1"), usd("0")}, 0},
+		{usd("1"), []int{1, 1}, []money.Amount{usd("1")}, 1},
+		{usd("1"), []int{1, 1}, []money.Amount{usd("0.49"), usd("0.50")}, 1},
+		{usd("1"), []int{1, 1}, []money.Amount{usd("0.48"), usd("0.52")}, 2},
+		{usd("1"), []int{1, 1}, []money.Amount{usd("0.495"), usd("0.505")}, 2},
+		{usd("1"), []int{1, 0}, []money.Amount{usd("0.99"), usd("0.01")}, 1},
+		{usd("1"), []int{0, 0}, []money.Amount{usd("1"), usd("0")}, 1},
+	}
+	for _, tt := range tests {
+		rec := &recorder{TB: t}
+		ok := AssertAllocationComplete(rec, tt.total, tt.ratios, tt.parts)
+		if len(rec.errors) != tt.wantErrs || ok != (tt.wantErrs == 0) {
+			t.Errorf("AssertAllocationComplete(%v, %v, %v) = %t, reported %q, want %v failures", tt.total, tt.ratios, tt.parts, ok, rec.errors, tt.wantErrs)
+		}
+	}
+}
+
+// TestMoney_Invariants checks the invariants on the money package itself.
+func TestMoney_Invariants(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 1_000 {
+		a := RandAmount(r, RandCurr(r))
+		ratios := make([]int, 1+r.IntN(10))
+		for i := range ratios {
+			ratios[i] = r.IntN(100)
+		}
+		ratios[r.IntN(len(ratios))]++
+
+		parts, err := a.Split(len(ratios))
+		if err != nil {
+			t.Fatalf("%v.Split(%v) failed: %v", a, len(ratios), err)
+		}
+		AssertSumPreserved(t, a, parts)
+
+		parts, err = a.Allocate(ratios...)
+		if err != nil {
+			t.Fatalf("%v.Allocate(%v) failed: %v", a, ratios, err)
+		}
+		AssertAllocationComplete(t, a, ratios, parts)
+
+		parts, err = a.AllocateSeeded("seed", ratios...)
+		if err != nil {
+			t.Fatalf("%v.AllocateSeeded(%v) failed: %v", a, ratios, err)
+		}
+		AssertAllocationComplete(t, a, ratios, parts)
+	}
+}
@@ -0,0 +1,62 @@
+// Code generated by scripts/currency/codegen.go. DO NOT EDIT.
+// Any changes made to this file will be overwritten the next time it is generated.
+
+package money
+
+// displayNameLookup contains the currency display names defined by the [CLDR]
+// for the most widely used locales.
+// Locales are identified either by language or by language and region.
+// Languages only contain names that differ from the ISO 4217 names, and
+// regions only contain names that differ from the names of their languages.
+//
+// [CLDR]: https://cldr.unicode.org
+var displayNameLookup = map[string]map[Currency]string{
+	"de": {
+		AUD: "Australischer Dollar",
+		CAD: "Kanadischer Dollar",
+		CHF: "Schweizer Franken",
+		CNY: "Renminbi Yuan",
+		GBP: "Britisches Pfund",
+		JPY: "Japanischer Yen",
+		USD: "US-Dollar",
+	},
+	"es": {
+		CHF: "franco suizo",
+		EUR: "euro",
+		GBP: "libra esterlina",
+		JPY: "yen",
+		MXN: "peso mexicano",
+		USD: "dólar estadounidense",
+	},
+	"fr": {
+		AUD: "dollar australien",
+		CAD: "dollar canadien",
+		CHF: "franc suisse",
+		CNY: "yuan renminbi chinois",
+		EUR: "euro",
+		GBP: "livre sterling",
+		JPY: "yen japonais",
+		USD: "dollar des États-Unis",
+	},
+	"fr-CA": {
+		USD: "dollar américain",
+	},
+	"it": {
+		CHF: "franco svizzero",
+		EUR: "euro",
+		GBP: "sterlina britannica",
+		JPY: "yen giapponese",
+		USD: "dollaro statunitense",
+	},
+	"ja": {
+		EUR: "ユーロ",
+		JPY: "日本円",
+		USD: "米ドル",
+	},
+	"zh": {
+		CNY: "人民币",
+		EUR: "欧元",
+		JPY: "日元",
+		USD: "美元",
+	},
+}
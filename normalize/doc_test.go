@@ -0,0 +1,49 @@
+package normalize_test
+
+import (
+	"fmt"
+
+	"github.com/lunafinancialgroup/money"
+	"github.com/lunafinancialgroup/money/normalize"
+)
+
+func ExampleFromLegacyFloat() {
+	x, y := 0.1, 0.2
+	fmt.Println(normalize.FromLegacyFloat("USD", 12.34, money.HalfEven))
+	fmt.Println(normalize.FromLegacyFloat("USD", x+y, money.HalfEven))
+	fmt.Println(normalize.FromLegacyFloat("USD", 12.345, money.HalfEven))
+	// Output:
+	// 12.34 -> USD 12.34 (Exact) <nil>
+	// 0.30000000000000004 -> USD 0.30 (Corrected) <nil>
+	// 12.345 -> USD 12.34 (Rounded) <nil>
+}
+
+func ExampleValidate() {
+	rows := []struct {
+		curr string
+		f    float64
+	}{
+		{"USD", 12.34},
+		{"EUR", 7.5},
+		{"USD", 12.345},
+		{"UUU", 1},
+	}
+	values := func(yield func(string, float64) bool) {
+		for _, r := range rows {
+			if !yield(r.curr, r.f) {
+				return
+			}
+		}
+	}
+	rep := normalize.Validate(values, money.HalfEven)
+	fmt.Println(rep)
+	fmt.Println(rep.OK())
+	for _, issue := range rep.Issues {
+		fmt.Println(issue)
+	}
+	// Output:
+	// 4 values: 2 exact, 0 corrected, 1 rounded, 1 failed
+	// false
+	// #2 USD: 12.345 -> USD 12.34 (Rounded)
+	// #3 UUU: normalizing 1: unknown currency "UUU"
+}
@@ -0,0 +1,215 @@
+/*
+Package normalize implements the migration of amounts stored as binary
+floating-point numbers, for example in float64 database columns or JSON
+numbers, to exact amounts.
+
+[FromLegacyFloat] converts a single float and classifies the conversion,
+so that every migrated value has an audit trail:
+  - [Exact]: the float is the closest float64 to an amount in the currency,
+    such as 12.34 for US Dollars.
+  - [Corrected]: the float differs from an amount in the currency by
+    a tiny error typical of float arithmetic, such as 0.30000000000000004
+    for 0.1 + 0.2, and the error was removed.
+  - [Rounded]: the float has significant digits beyond the scale of
+    the currency, such as 12.345 for US Dollars, which were rounded off.
+    Such values usually indicate a defect in the legacy system and should be
+    reviewed.
+
+[Validate] converts a whole dataset and returns a [Report] with counts and
+the list of values that were not exact.
+*/
+package normalize
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"github.com/lunafinancialgroup/money"
+)
+
+// Status describes how a float was converted to an amount.
+type Status int8
+
+const (
+	// Exact means that the float is the closest float64 to the amount.
+	Exact Status = iota
+	// Corrected means that the float differed from the amount by less than
+	// the tolerance of float arithmetic, see [FromLegacyFloat].
+	Corrected
+	// Rounded means that the float had significant digits beyond the scale of
+	// the currency, which were rounded off.
+	Rounded
+)
+
+// String implements the [fmt.Stringer] interface and returns the name of
+// the status.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s Status) String() string {
+	switch s {
+	case Exact:
+		return "Exact"
+	case Corrected:
+		return "Corrected"
+	case Rounded:
+		return "Rounded"
+	default:
+		return fmt.Sprintf("Status(%d)", int8(s))
+	}
+}
+
+// Result describes the conversion of a float to an amount.
+type Result struct {
+	Input    float64      // Float that was converted
+	Amount   money.Amount // Amount rounded to the scale of its currency
+	Status   Status       // How the float was converted
+	Residual float64      // Input minus the closest float64 to Amount
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the result, for example
+// "0.30000000000000004 -> USD 0.30 (Corrected)".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (r Result) String() string {
+	return fmt.Sprintf("%v -> %v (%v)", strconv.FormatFloat(r.Input, 'g', -1, 64), r.Amount, r.Status)
+}
+
+// tolerance is the largest error of float arithmetic that is removed
+// silently, in units in the last place of the float.
+const tolerance = 4
+
+// FromLegacyFloat converts a float to an amount rounded to the scale of
+// the currency using the specified rounding mode, and reports whether
+// the conversion was exact.
+// The float is considered [Corrected] rather than [Rounded] if it differs
+// from the closest float64 to the amount by at most 4 units in the last place
+// of the float, or by at most a millionth of the minor unit of the currency,
+// whichever is larger.
+// See also [money.NewAmountFromFloat64Round].
+//
+// FromLegacyFloat returns an error if:
+//   - the currency code is not valid;
+//   - the float is a special value (NaN or Inf);
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [money.Currency.Scale]) digits.
+func FromLegacyFloat(curr string, f float64, mode money.RoundingMode) (Result, error) {
+	r, err := fromLegacyFloat(curr, f, mode)
+	if err != nil {
+		return Result{}, fmt.Errorf("normalizing %v: %w", f, err)
+	}
+	return r, nil
+}
+
+func fromLegacyFloat(curr string, f float64, mode money.RoundingMode) (Result, error) {
+	m, err := money.ParseCurr(curr)
+	if err != nil {
+		return Result{}, err
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Result{}, fmt.Errorf("special value %v", f)
+	}
+
+	// Shortest decimal representation of the float, with digits beyond
+	// the precision of decimals removed
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	whole, frac, _ := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	if n := decimal.MaxPrec - len(whole); n >= 0 && len(frac) > n {
+		s = strconv.FormatFloat(f, 'f', n, 64)
+	}
+	a, err := money.ParseAmount(m.Code(), s)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(frac) <= m.Scale() {
+		return Result{Input: f, Amount: a, Status: Exact}, nil
+	}
+
+	// Difference between the float and the rounded amount
+	a = a.RoundWith(m.Scale(), mode)
+	g, _ := a.Float64()
+	res := Result{Input: f, Amount: a, Status: Rounded, Residual: f - g}
+	ulp := math.Nextafter(math.Abs(f), math.Inf(1)) - math.Abs(f)
+	minor := math.Pow10(-m.Scale())
+	if math.Abs(res.Residual) <= max(tolerance*ulp, minor/1e6) {
+		res.Status = Corrected
+	}
+	return res, nil
+}
+
+// Issue is a value of a dataset that was not converted exactly.
+type Issue struct {
+	Index  int    // Position of the value in the dataset
+	Curr   string // Currency code of the value
+	Result Result // Result of the conversion, if Err is nil
+	Err    error  // Error of the conversion, if any
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the issue.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (i Issue) String() string {
+	if i.Err != nil {
+		return fmt.Sprintf("#%v %v: %v", i.Index, i.Curr, i.Err)
+	}
+	return fmt.Sprintf("#%v %v: %v", i.Index, i.Curr, i.Result)
+}
+
+// Report summarizes the conversion of a dataset.
+type Report struct {
+	Total     int     // Number of values
+	Exact     int     // Number of values converted exactly
+	Corrected int     // Number of values with removed float errors
+	Rounded   int     // Number of values with rounded off digits
+	Failed    int     // Number of values that could not be converted
+	Issues    []Issue // Values that were not converted exactly, in order
+}
+
+// OK returns true if no values were rounded off or failed to convert.
+func (r Report) OK() bool {
+	return r.Rounded == 0 && r.Failed == 0
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a summary of the report.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (r Report) String() string {
+	return fmt.Sprintf("%v values: %v exact, %v corrected, %v rounded, %v failed",
+		r.Total, r.Exact, r.Corrected, r.Rounded, r.Failed)
+}
+
+// Validate converts every value of the dataset using [FromLegacyFloat] and
+// reports the values that were not converted exactly.
+// The dataset is a sequence of currency codes and floats, for example rows
+// of a database table.
+// Validate does not stop at errors, they are recorded in the report.
+func Validate(values iter.Seq2[string, float64], mode money.RoundingMode) Report {
+	var rep Report
+	for curr, f := range values {
+		i := rep.Total
+		rep.Total++
+		r, err := FromLegacyFloat(curr, f, mode)
+		if err != nil {
+			rep.Failed++
+			rep.Issues = append(rep.Issues, Issue{Index: i, Curr: curr, Err: err})
+			continue
+		}
+		switch r.Status {
+		case Exact:
+			rep.Exact++
+			continue
+		case Corrected:
+			rep.Corrected++
+		case Rounded:
+			rep.Rounded++
+		}
+		rep.Issues = append(rep.Issues, Issue{Index: i, Curr: curr, Result: r})
+	}
+	return rep
+}
@@ -0,0 +1,142 @@
+package normalize
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/lunafinancialgroup/money"
+)
+
+// Variables prevent exact constant arithmetic of the compiler.
+var tenth, fifth, eleventh = 0.1, 0.2, 1.1
+
+func TestFromLegacyFloat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr       string
+			f          float64
+			mode       money.RoundingMode
+			want       string
+			wantStatus Status
+		}{
+			// Exact
+			{"USD", 0, money.HalfEven, "0.00", Exact},
+			{"USD", math.Copysign(0, -1), money.HalfEven, "0.00", Exact},
+			{"USD", 12.34, money.HalfEven, "12.34", Exact},
+			{"USD", -12.3, money.HalfEven, "-12.30", Exact},
+			{"JPY", 1000, money.HalfEven, "1000", Exact},
+			{"OMR", 1.234, money.HalfEven, "1.234", Exact},
+			{"USD", 99999999999999.98, money.HalfEven, "99999999999999.98", Exact},
+
+			// Corrected
+			{"USD", tenth + fifth, money.HalfEven, "0.30", Corrected},
+			{"USD", eleventh * eleventh, money.HalfEven, "1.21", Corrected},
+			{"USD", -(tenth + fifth), money.HalfEven, "-0.30", Corrected},
+			{"USD", 1e-20, money.HalfEven, "0.00", Corrected},
+			{"JPY", 999.9999999999999, money.HalfEven, "1000", Corrected},
+
+			// Rounded
+			{"USD", 12.345, money.HalfEven, "12.34", Rounded},
+			{"USD", 12.345, money.HalfUp, "12.35", Rounded},
+			{"USD", 0.001, money.HalfEven, "0.00", Rounded},
+			{"JPY", 0.5, money.HalfEven, "0", Rounded},
+			{"JPY", 0.5, money.Ceiling, "1", Rounded},
+		}
+		for _, tt := range tests {
+			got, err := FromLegacyFloat(tt.curr, tt.f, tt.mode)
+			if err != nil {
+				t.Errorf("FromLegacyFloat(%q, %v, %v) failed: %v", tt.curr, tt.f, tt.mode, err)
+				continue
+			}
+			want := money.MustParseAmount(tt.curr, tt.want)
+			if got.Amount != want || got.Status != tt.wantStatus || got.Input != tt.f {
+				t.Errorf("FromLegacyFloat(%q, %v, %v) = %v, want %v (%v)", tt.curr, tt.f, tt.mode, got, want, tt.wantStatus)
+			}
+			if got.Status == Exact && got.Residual != 0 {
+				t.Errorf("FromLegacyFloat(%q, %v, %v).Residual = %v, want 0", tt.curr, tt.f, tt.mode, got.Residual)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			curr string
+			f    float64
+		}{
+			{"UUU", 1},
+			{"USD", math.NaN()},
+			{"USD", math.Inf(1)},
+			{"USD", math.Inf(-1)},
+			{"USD", 1e18},
+		}
+		for _, tt := range tests {
+			_, err := FromLegacyFloat(tt.curr, tt.f, money.HalfEven)
+			if err == nil {
+				t.Errorf("FromLegacyFloat(%q, %v) did not fail", tt.curr, tt.f)
+			}
+		}
+	})
+}
+
+func TestStatus_String(t *testing.T) {
+	tests := []struct {
+		s    Status
+		want string
+	}{
+		{Exact, "Exact"},
+		{Corrected, "Corrected"},
+		{Rounded, "Rounded"},
+		{Status(7), "Status(7)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("Status(%d).String() = %q, want %q", int8(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	rows := []struct {
+		curr string
+		f    float64
+	}{
+		{"USD", 12.34},
+		{"USD", tenth + fifth},
+		{"EUR", 7},
+		{"USD", 12.345},
+		{"UUU", 1},
+		{"JPY", 500},
+	}
+	seq := func(yield func(string, float64) bool) {
+		for _, r := range rows {
+			if !yield(r.curr, r.f) {
+				return
+			}
+		}
+	}
+	got := Validate(seq, money.HalfEven)
+	if got.Total != 6 || got.Exact != 3 || got.Corrected != 1 || got.Rounded != 1 || got.Failed != 1 {
+		t.Errorf("Validate() = %v", got)
+	}
+	if got.OK() {
+		t.Errorf("Validate().OK() = true, want false")
+	}
+	indexes := make([]int, len(got.Issues))
+	for i, issue := range got.Issues {
+		indexes[i] = issue.Index
+	}
+	if want := []int{1, 3, 4}; !slices.Equal(indexes, want) {
+		t.Errorf("Validate().Issues indexes = %v, want %v", indexes, want)
+	}
+	if got.Issues[2].Err == nil {
+		t.Errorf("Validate().Issues[2].Err = nil, want error")
+	}
+
+	// Clean dataset
+	clean := Validate(maps.All(map[string]float64{"USD": 1.5, "JPY": 100}), money.HalfEven)
+	if !clean.OK() || clean.Exact != 2 || len(clean.Issues) != 0 {
+		t.Errorf("Validate() = %v, want all exact", clean)
+	}
+}
@@ -0,0 +1,220 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// pattern represents a number pattern in the [CLDR] syntax, such as
+// "¤#,##0.00;(¤#,##0.00)".
+//
+// [CLDR]: https://unicode.org/reports/tr35/tr35-numbers.html#Number_Patterns
+type pattern struct {
+	posPrefix, posSuffix []affixPart
+	negPrefix, negSuffix []affixPart
+	grouped              bool // integer digits are separated into groups of 3
+	minFrac, maxFrac     int  // number of digits after the decimal point
+}
+
+// affixPart is a literal text or a currency placeholder in the prefix or
+// suffix of a pattern.
+type affixPart struct {
+	lit  string
+	kind affixKind
+}
+
+type affixKind int8
+
+const (
+	affixLiteral affixKind = iota
+	affixSymbol            // ¤, the currency symbol of the locale
+	affixCode              // ¤¤, the ISO 4217 code of the currency
+)
+
+// parsePattern parses a pattern that consists of a positive subpattern and
+// an optional negative subpattern separated by a semicolon.
+// Only the prefix and the suffix of the negative subpattern are used,
+// if it is omitted, negative amounts use the positive subpattern prefixed
+// with a minus sign.
+func parsePattern(s string) (*pattern, error) {
+	pos, neg, hasNeg, err := splitPattern(s)
+	if err != nil {
+		return nil, err
+	}
+	p := new(pattern)
+	var num string
+	p.posPrefix, num, p.posSuffix, err = parseSubpattern(pos)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.parseNumber(num); err != nil {
+		return nil, err
+	}
+	if !hasNeg {
+		p.negPrefix = append([]affixPart{{lit: "-"}}, p.posPrefix...)
+		p.negSuffix = p.posSuffix
+		return p, nil
+	}
+	p.negPrefix, _, p.negSuffix, err = parseSubpattern(neg)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// splitPattern splits a pattern into its positive and negative subpatterns
+// at the first semicolon outside of quotes.
+func splitPattern(s string) (pos, neg string, hasNeg bool, err error) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return s[:i], s[i+1:], true, nil
+			}
+		}
+	}
+	if quoted {
+		return "", "", false, fmt.Errorf("unterminated quote")
+	}
+	return s, "", false, nil
+}
+
+// isPatternDigit returns true if the rune is a part of the number in a pattern.
+func isPatternDigit(r rune) bool {
+	return r == '#' || r == '0' || r == ',' || r == '.'
+}
+
+// parseSubpattern splits a subpattern into its prefix, number, and suffix.
+func parseSubpattern(s string) (prefix []affixPart, num string, suffix []affixPart, err error) {
+	prefix, s, err = parseAffix(s)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	end := strings.IndexFunc(s, func(r rune) bool { return !isPatternDigit(r) })
+	if end < 0 {
+		end = len(s)
+	}
+	num = s[:end]
+	if num == "" {
+		return nil, "", nil, fmt.Errorf("missing number")
+	}
+	suffix, s, err = parseAffix(s[end:])
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if s != "" {
+		return nil, "", nil, fmt.Errorf("unexpected %q after number", s)
+	}
+	return prefix, num, suffix, nil
+}
+
+// parseAffix parses the prefix or suffix at the beginning of the string
+// and returns the rest of the string, which starts with a number.
+func parseAffix(s string) (parts []affixPart, rest string, err error) {
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			parts = append(parts, affixPart{lit: lit.String()})
+			lit.Reset()
+		}
+	}
+	quoted := false
+	for s != "" {
+		switch {
+		case strings.HasPrefix(s, "''"):
+			lit.WriteByte('\'') // '' is a literal quote, both inside and outside quotes
+			s = s[2:]
+		case s[0] == '\'':
+			quoted = !quoted
+			s = s[1:]
+		case quoted:
+			lit.WriteByte(s[0])
+			s = s[1:]
+		case strings.HasPrefix(s, "¤¤¤"):
+			return nil, "", fmt.Errorf("currency names are not supported")
+		case strings.HasPrefix(s, "¤¤"):
+			flush()
+			parts = append(parts, affixPart{kind: affixCode})
+			s = s[len("¤¤"):]
+		case strings.HasPrefix(s, "¤"):
+			flush()
+			parts = append(parts, affixPart{kind: affixSymbol})
+			s = s[len("¤"):]
+		case isPatternDigit(rune(s[0])):
+			flush()
+			return parts, s, nil
+		default:
+			lit.WriteByte(s[0])
+			s = s[1:]
+		}
+	}
+	if quoted {
+		return nil, "", fmt.Errorf("unterminated quote")
+	}
+	flush()
+	return parts, "", nil
+}
+
+// parseNumber parses the number part of the positive subpattern, such as
+// "#,##0.00".
+func (p *pattern) parseNumber(num string) error {
+	intPart, fracPart, hasPoint := strings.Cut(num, ".")
+	// Integer part must match #*0+ with optional group separators,
+	// fractional part must match 0*#*.
+	intDigits := strings.TrimLeft(strings.ReplaceAll(intPart, ",", ""), "#")
+	switch {
+	case intDigits == "" || strings.Trim(intDigits, "0") != "":
+		return fmt.Errorf("invalid integer part in number %q", num)
+	case hasPoint && fracPart == "",
+		strings.Trim(strings.TrimLeft(fracPart, "0"), "#") != "":
+		return fmt.Errorf("invalid fractional part in number %q", num)
+	}
+	p.grouped = strings.ContainsRune(intPart, ',')
+	p.minFrac = strings.Count(fracPart, "0")
+	p.maxFrac = len(fracPart)
+	if p.maxFrac > decimal.MaxScale {
+		return fmt.Errorf("number %q has more than %v fraction digits", num, decimal.MaxScale)
+	}
+	return nil
+}
+
+// append appends the amount formatted according to the pattern and
+// the locale to the byte slice.
+// The amount is rounded to the maximum number of fraction digits of
+// the pattern using rounding half to even, and then trailing zeros are
+// removed down to the minimum number of fraction digits.
+func (p *pattern) append(text []byte, l locale, a Amount) []byte {
+	d := a.Decimal()
+	d = d.Round(p.maxFrac).Trim(p.minFrac).Pad(p.minFrac)
+	prefix, suffix := p.posPrefix, p.posSuffix
+	if d.IsNeg() {
+		prefix, suffix = p.negPrefix, p.negSuffix
+		d = d.Neg()
+	}
+	group := ""
+	if p.grouped {
+		group = l.group
+	}
+	text = appendAffix(text, prefix, l, a.Curr())
+	text = appendGrouped(text, d, group, l.point)
+	return appendAffix(text, suffix, l, a.Curr())
+}
+
+func appendAffix(text []byte, parts []affixPart, l locale, c Currency) []byte {
+	for _, part := range parts {
+		switch part.kind {
+		case affixSymbol:
+			text = append(text, l.symbol(c)...)
+		case affixCode:
+			text = append(text, c.Code()...)
+		default:
+			text = append(text, part.lit...)
+		}
+	}
+	return text
+}
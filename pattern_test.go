@@ -0,0 +1,119 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestFormatter_WithPattern(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			tag, pat, m, d, want string
+		}{
+			// Symbol and code
+			{"en", "¤#,##0.00", "USD", "1234.5", "$1,234.50"},
+			{"en", "#,##0.00 ¤¤", "USD", "1234.5", "1,234.50 USD"},
+			{"en", "¤¤ #,##0.00", "EUR", "-1234.5", "-EUR 1,234.50"},
+			{"en", "#,##0.00 ¤", "EUR", "1234.5", "1,234.50 €"},
+
+			// Locale separators and symbols
+			{"de-DE", "¤ #,##0.00", "EUR", "1234.5", "€ 1.234,50"},
+			{"fr-FR", "#,##0.00 ¤", "EUR", "1234.5", "1 234,50 €"},
+			{"ja-JP", "¤#,##0", "JPY", "1234", "￥1,234"},
+
+			// Grouping
+			{"en", "¤0.00", "USD", "1234567.891", "$1234567.89"},
+			{"en", "¤#,##0.00", "USD", "1234567.891", "$1,234,567.89"},
+
+			// Fraction digits
+			{"en", "¤#,##0", "USD", "1234.5", "$1,234"},
+			{"en", "¤#,##0", "USD", "1235.5", "$1,236"},
+			{"en", "¤#,##0.##", "USD", "5.00", "$5"},
+			{"en", "¤#,##0.##", "USD", "5.50", "$5.5"},
+			{"en", "¤#,##0.##", "USD", "5.555", "$5.56"},
+			{"en", "¤#,##0.00##", "USD", "5.5", "$5.50"},
+			{"en", "¤#,##0.00##", "USD", "5.12345", "$5.1234"},
+			{"en", "¤#,##0.000", "JPY", "5", "¥5.000"},
+
+			// Negative subpatterns
+			{"en", "¤#,##0.00;(¤#,##0.00)", "USD", "-1234.5", "($1,234.50)"},
+			{"en", "¤#,##0.00;(¤#,##0.00)", "USD", "1234.5", "$1,234.50"},
+			{"en", "¤#,##0.00;(¤#)", "USD", "-1234.5", "($1,234.50)"},
+			{"en", "#,##0.00 ¤;#,##0.00- ¤", "USD", "-1.5", "1.50- $"},
+			{"en", "¤#,##0.00;(¤#,##0.00)", "USD", "-0.001", "$0.00"},
+			{"en", "¤#,##0.00", "USD", "-0.001", "$0.00"},
+			{"en", "¤#,##0.00", "USD", "0", "$0.00"},
+
+			// Quoted literals
+			{"en", "'#'0.00", "USD", "5", "#5.00"},
+			{"en", "0.00' ¤'", "USD", "5", "5.00 ¤"},
+			{"en", "0.00 'o''clock'", "USD", "5", "5.00 o'clock"},
+			{"en", "0.00''", "USD", "5", "5.00'"},
+			{"en", "'a;b'0;'c'0", "USD", "-5", "c5"},
+		}
+		for _, tt := range tests {
+			f, err := MustNewFormatter(tt.tag).WithPattern(tt.pat)
+			if err != nil {
+				t.Errorf("WithPattern(%q) failed: %v", tt.pat, err)
+				continue
+			}
+			a := MustParseAmount(tt.m, tt.d)
+			if got := f.Format(a); got != tt.want {
+				t.Errorf("NewFormatter(%q).WithPattern(%q).Format(%q) = %q, want %q", tt.tag, tt.pat, a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("scale", func(t *testing.T) {
+		f, err := MustNewFormatter("en").WithScale(USD, 4)
+		if err != nil {
+			t.Fatalf("WithScale(%v, %v) failed: %v", USD, 4, err)
+		}
+		f, err = f.WithPattern("¤0.0")
+		if err != nil {
+			t.Fatalf("WithPattern(%q) failed: %v", "¤0.0", err)
+		}
+		a := MustParseAmount("USD", "1.25")
+		if got, want := f.Format(a), "$1.2"; got != want {
+			t.Errorf("Format(%q) = %q, want %q", a, got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"",
+			"¤",
+			"¤;¤0",
+			"¤0.",
+			"¤#",
+			"¤#,##",
+			"¤0#",
+			"¤0.#0",
+			"¤0.0.0",
+			"¤0.0,0",
+			"¤0 ¤ 0",
+			"'¤0",
+			"¤0;'",
+			"¤¤¤0",
+			"0.00000000000000000000",
+		}
+		for _, tt := range tests {
+			_, err := MustNewFormatter("en").WithPattern(tt)
+			if err == nil {
+				t.Errorf("WithPattern(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func BenchmarkFormatter_WithPattern(b *testing.B) {
+	f, err := MustNewFormatter("en").WithPattern("¤#,##0.00;(¤#,##0.00)")
+	if err != nil {
+		b.Fatal(err)
+	}
+	a := MustParseAmount("USD", "-1234.56")
+	text := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		textSink = f.AppendFormat(text[:0], a)
+	}
+}
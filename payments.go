@@ -0,0 +1,388 @@
+package money
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// ISO20022Amount represents an amount that is encoded in XML as an element of
+// an [ISO 20022] message, such as pacs.008 or camt.053, with the currency code
+// in the Ccy attribute, for example <InstdAmt Ccy="USD">1234.56</InstdAmt>.
+// The element name is taken from the enclosing struct field.
+//
+// ISO 20022 amounts are never negative, since the direction of a payment is
+// indicated by other elements of the message, such as CdtDbtInd.
+// Amounts are neither rounded nor padded silently: their number of digits
+// after the decimal point must not exceed the scale of the currency.
+//
+// [ISO 20022]: https://www.iso20022.org
+type ISO20022Amount struct {
+	Amount Amount
+}
+
+const (
+	iso20022MaxDigits = 18 // maximum number of significant digits in ISO 20022 amounts
+	swiftMaxChars     = 15 // maximum length of SWIFT MT amounts, including the comma
+)
+
+// MarshalXML implements the [xml.Marshaler] interface.
+// MarshalXML always writes the value with as many digits after the decimal
+// point as the scale of the currency, for example 1234.50 for US Dollars.
+//
+// MarshalXML returns an error if:
+//   - the amount is negative;
+//   - the amount has non-zero digits beyond the scale of its currency;
+//   - the amount has more than 18 significant digits.
+//
+// [xml.Marshaler]: https://pkg.go.dev/encoding/xml#Marshaler
+func (x ISO20022Amount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	d, err := paymentDecimal(x.Amount)
+	if err == nil && d.Prec() > iso20022MaxDigits {
+		err = fmt.Errorf("amount has more than %v digits", iso20022MaxDigits)
+	}
+	if err != nil {
+		return fmt.Errorf("marshaling %T: %w", ISO20022Amount{}, err)
+	}
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "Ccy"}, Value: x.Amount.Curr().Code()})
+	return e.EncodeElement(d.String(), start)
+}
+
+// UnmarshalXML implements the [xml.Unmarshaler] interface.
+// The element must have a Ccy attribute with a 3-letter currency code,
+// and its value must consist of decimal digits with an optional decimal point.
+// See also method [ISO20022Amount.MarshalXML].
+//
+// UnmarshalXML returns an error if:
+//   - the Ccy attribute is missing or is not a known currency code;
+//   - the value has a sign, an exponent, or other invalid characters;
+//   - the value has more digits after the decimal point than the scale of the currency;
+//   - the value has more than 18 significant digits.
+//
+// [xml.Unmarshaler]: https://pkg.go.dev/encoding/xml#Unmarshaler
+func (x *ISO20022Amount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		Ccy   string `xml:"Ccy,attr"`
+		Value string `xml:",chardata"`
+	}
+	err := d.DecodeElement(&v, &start)
+	if err == nil {
+		x.Amount, err = parseISO20022Amount(v.Ccy, strings.TrimSpace(v.Value))
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", ISO20022Amount{}, err)
+	}
+	return nil
+}
+
+func parseISO20022Amount(curr, amount string) (Amount, error) {
+	if curr == "" {
+		return Amount{}, fmt.Errorf("missing Ccy attribute")
+	}
+	m, err := parseCode(curr)
+	if err != nil {
+		return Amount{}, err
+	}
+	if !isPaymentNumber(amount, '.') {
+		return Amount{}, &InvalidAmountError{Input: amount}
+	}
+	d, err := newPaymentAmount(m, amount)
+	if err != nil {
+		return Amount{}, err
+	}
+	if d.Decimal().Prec() > iso20022MaxDigits {
+		return Amount{}, fmt.Errorf("amount has more than %v digits", iso20022MaxDigits)
+	}
+	return d, nil
+}
+
+// SWIFTAmount returns a string representation of the amount suitable for
+// the currency and amount subfields of [SWIFT MT] fields, such as 32A and 32B,
+// for example "USD1234,56".
+// The amount is written with a comma as the decimal separator and with
+// as many digits after it as the scale of the currency, for example
+// "JPY1000," for Japanese Yen.
+// The value date of field 32A is not included.
+// See also function [ParseSWIFTAmount].
+//
+// SWIFTAmount returns an error if:
+//   - the amount is negative;
+//   - the amount has non-zero digits beyond the scale of its currency;
+//   - the amount has more than 15 characters, including the comma.
+//
+// [SWIFT MT]: https://www2.swift.com/knowledgecentre/products/Standards%20MT
+func (a Amount) SWIFTAmount() (string, error) {
+	d, err := paymentDecimal(a)
+	if err != nil {
+		return "", fmt.Errorf("formatting %v as SWIFT amount: %w", a, err)
+	}
+	s := d.String()
+	if d.Scale() == 0 {
+		s += ","
+	} else {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	if len(s) > swiftMaxChars {
+		return "", fmt.Errorf("formatting %v as SWIFT amount: amount has more than %v characters", a, swiftMaxChars)
+	}
+	return a.Curr().Code() + s, nil
+}
+
+// ParseSWIFTAmount converts the currency and amount subfields of [SWIFT MT]
+// fields, such as 32A and 32B, to an amount, for example "USD1234,56" or
+// "JPY1000,".
+// The value date of field 32A must be removed before parsing.
+// See also method [Amount.SWIFTAmount].
+//
+// ParseSWIFTAmount returns an error if:
+//   - the string does not start with a known 3-letter currency code;
+//   - the amount does not contain exactly one comma, or does not have
+//     a digit before it;
+//   - the amount has a sign or other invalid characters;
+//   - the amount has more digits after the comma than the scale of the currency;
+//   - the amount has more than 15 characters, including the comma.
+//
+// [SWIFT MT]: https://www2.swift.com/knowledgecentre/products/Standards%20MT
+func ParseSWIFTAmount(s string) (Amount, error) {
+	a, err := parseSWIFTAmount(s)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing SWIFT amount %q: %w", s, err)
+	}
+	return a, nil
+}
+
+func parseSWIFTAmount(s string) (Amount, error) {
+	if len(s) < 3 {
+		return Amount{}, fmt.Errorf("missing currency code")
+	}
+	curr, amount := s[:3], s[3:]
+	m, err := parseCode(curr)
+	if err != nil {
+		return Amount{}, err
+	}
+	if len(amount) > swiftMaxChars {
+		return Amount{}, fmt.Errorf("amount has more than %v characters", swiftMaxChars)
+	}
+	if strings.Count(amount, ",") != 1 || !isPaymentNumber(amount, ',') {
+		return Amount{}, &InvalidAmountError{Input: amount}
+	}
+	amount = strings.TrimSuffix(strings.Replace(amount, ",", ".", 1), ".")
+	return newPaymentAmount(m, amount)
+}
+
+// parseCode converts an uppercase 3-letter code to a currency.
+// Unlike [ParseCurr], it does not accept numeric or lowercase codes.
+func parseCode(curr string) (Currency, error) {
+	if len(curr) != 3 || strings.IndexFunc(curr, isNotUpper) >= 0 {
+		return XXX, &UnknownCurrencyError{Code: curr}
+	}
+	return ParseCurr(curr)
+}
+
+// isPaymentNumber reports whether the string consists of at least one digit
+// followed by an optional decimal separator and more digits.
+func isPaymentNumber(s string, point byte) bool {
+	if s == "" || s[0] == point {
+		return false
+	}
+	seen := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+		case c == point && !seen:
+			seen = true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newPaymentAmount creates an amount from a string that has already been
+// validated by isPaymentNumber with a dot as the decimal separator.
+func newPaymentAmount(m Currency, amount string) (Amount, error) {
+	d, err := decimal.Parse(amount)
+	if err != nil {
+		return Amount{}, &InvalidAmountError{Input: amount, Err: decimalErr(err)}
+	}
+	if d.Scale() > m.Scale() {
+		return Amount{}, fmt.Errorf("amount has more than %v digits after the decimal point", m.Scale())
+	}
+	return newAmountSafe(m, d)
+}
+
+// paymentDecimal returns the value of the amount with as many digits after
+// the decimal point as the scale of its currency, ensuring that it is
+// neither negative nor more precise than its currency.
+func paymentDecimal(a Amount) (decimal.Decimal, error) {
+	m := a.Curr()
+	if a.IsNeg() {
+		return decimal.Decimal{}, fmt.Errorf("amount must be non-negative, got %v", a)
+	}
+	if a.TrimToCurr().Scale() > m.Scale() {
+		return decimal.Decimal{}, fmt.Errorf("amount has more than %v digits after the decimal point", m.Scale())
+	}
+	return a.TrimToCurr().Decimal().Pad(m.Scale()), nil
+}
+
+// Provider describes how a payment service provider, such as Stripe or Adyen,
+// represents amounts as integers in its API.
+// Most providers expect amounts in minor units of the currency, as returned by
+// [Amount.MinorUnits], but some of them use a different number of digits
+// after the decimal point for some currencies than the ISO 4217 standard.
+// For example, Stripe expects Icelandic Krona amounts multiplied by 100 even
+// though the Krona has no minor units.
+// The zero value is a provider without exceptions to ISO 4217.
+// See also variable [Stripe].
+type Provider struct {
+	name   string
+	scales map[Currency]int
+}
+
+// NewProvider returns a provider that uses the given number of digits after
+// the decimal point for the given currencies, and the scales defined by
+// ISO 4217 for all other currencies.
+// The exceptions map currency codes to scales, for example {"ISK": 2}.
+//
+// NewProvider returns an error if:
+//   - any currency code is not valid;
+//   - any scale is negative or greater than [decimal.MaxScale].
+func NewProvider(name string, exceptions map[string]int) (Provider, error) {
+	p := Provider{name: name, scales: make(map[Currency]int, len(exceptions))}
+	for code, scale := range exceptions {
+		m, err := ParseCurr(code)
+		if err != nil {
+			return Provider{}, fmt.Errorf("creating provider %q: %w", name, err)
+		}
+		if scale < 0 || scale > decimal.MaxScale {
+			return Provider{}, fmt.Errorf("creating provider %q: scale of %v must be between 0 and %v", name, m, decimal.MaxScale)
+		}
+		p.scales[m] = scale
+	}
+	return p, nil
+}
+
+// MustNewProvider is like [NewProvider] but panics if the provider cannot be created.
+// It simplifies safe initialization of global variables holding providers.
+func MustNewProvider(name string, exceptions map[string]int) Provider {
+	p, err := NewProvider(name, exceptions)
+	if err != nil {
+		panic(fmt.Sprintf("NewProvider(%q, %v) failed: %v", name, exceptions, err))
+	}
+	return p
+}
+
+// Stripe is the [Stripe] payment service provider.
+// Stripe expects Icelandic Krona and Ugandan Shilling amounts multiplied by 100
+// despite their lack of minor units, and Malagasy Ariary amounts without
+// minor units.
+// The exceptions were taken from the Stripe documentation on
+// [zero-decimal currencies] and may change, in which case a custom provider
+// can be created with [NewProvider].
+//
+// [Stripe]: https://stripe.com
+// [zero-decimal currencies]: https://docs.stripe.com/currencies#zero-decimal
+var Stripe = MustNewProvider("Stripe", map[string]int{
+	"ISK": 2,
+	"MGA": 0,
+	"UGX": 2,
+})
+
+// Name returns the name of the provider.
+func (p Provider) Name() string {
+	return p.name
+}
+
+// Scale returns the number of digits after the decimal point that
+// the provider uses for amounts in the currency.
+// See also method [Currency.Scale].
+func (p Provider) Scale(curr Currency) int {
+	if s, ok := p.scales[curr]; ok {
+		return s
+	}
+	return curr.Scale()
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// the name of the provider.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (p Provider) String() string {
+	return p.name
+}
+
+// ProviderUnits returns the amount as an integer in the units that
+// the provider expects, for example 12345 for "USD 123.45" and 50000 for
+// "ISK 500" with [Stripe].
+// If the amount has more digits after the decimal point than the provider
+// uses for its currency, it is rounded using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// See also constructor [NewAmountFromProviderUnits].
+//
+// ProviderUnits returns an error if the result cannot be represented as
+// an int64.
+func (a Amount) ProviderUnits(p Provider, mode ...RoundingMode) (int64, error) {
+	units, err := a.providerUnits(p, mode...)
+	if err != nil {
+		return 0, fmt.Errorf("converting %v to %v units: %w", a, p, err)
+	}
+	return units, nil
+}
+
+func (a Amount) providerUnits(p Provider, mode ...RoundingMode) (int64, error) {
+	scale := p.Scale(a.Curr())
+	d := a.RoundWith(scale, mode...).Decimal()
+	d = d.Trim(scale).Pad(scale)
+	if d.Scale() != scale {
+		return 0, ErrOverflow
+	}
+	u := d.Coef()
+	if d.IsNeg() {
+		if u > -math.MinInt64 {
+			return 0, ErrOverflow
+		}
+		return -int64(u), nil //nolint:gosec
+	}
+	if u > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(u), nil //nolint:gosec
+}
+
+// NewAmountFromProviderUnits converts an integer in the units that
+// the provider uses for the currency to an amount, for example 50000 to
+// "ISK 500" with [Stripe].
+// Digits after the decimal point beyond the scale of the currency are kept
+// only if they are not zero.
+// See also method [Amount.ProviderUnits].
+//
+// NewAmountFromProviderUnits returns an error if:
+//   - the currency code is not valid;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func NewAmountFromProviderUnits(p Provider, curr string, units int64) (Amount, error) {
+	a, err := newAmountFromProviderUnits(p, curr, units)
+	if err != nil {
+		return Amount{}, fmt.Errorf("converting %v %v units: %w", p, curr, err)
+	}
+	return a, nil
+}
+
+func newAmountFromProviderUnits(p Provider, curr string, units int64) (Amount, error) {
+	m, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, err
+	}
+	d, err := decimal.New(units, p.Scale(m))
+	if err != nil {
+		return Amount{}, decimalErr(err)
+	}
+	d = d.Trim(m.Scale()).Pad(m.Scale())
+	if d.Scale() < m.Scale() {
+		return Amount{}, ErrOverflow
+	}
+	return newAmountSafe(m, d)
+}
@@ -0,0 +1,372 @@
+package money
+
+import (
+	"encoding/xml"
+	"errors"
+	"math"
+	"testing"
+)
+
+type iso20022Payment struct {
+	XMLName  xml.Name       `xml:"CdtTrfTxInf"`
+	InstdAmt ISO20022Amount `xml:"InstdAmt"`
+}
+
+func TestISO20022Amount_MarshalXML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, a, want string
+		}{
+			{"USD", "1234.56", `<CdtTrfTxInf><InstdAmt Ccy="USD">1234.56</InstdAmt></CdtTrfTxInf>`},
+			{"USD", "1234.5", `<CdtTrfTxInf><InstdAmt Ccy="USD">1234.50</InstdAmt></CdtTrfTxInf>`},
+			{"USD", "1234.5000", `<CdtTrfTxInf><InstdAmt Ccy="USD">1234.50</InstdAmt></CdtTrfTxInf>`},
+			{"USD", "0", `<CdtTrfTxInf><InstdAmt Ccy="USD">0.00</InstdAmt></CdtTrfTxInf>`},
+			{"JPY", "1000", `<CdtTrfTxInf><InstdAmt Ccy="JPY">1000</InstdAmt></CdtTrfTxInf>`},
+			{"OMR", "1.5", `<CdtTrfTxInf><InstdAmt Ccy="OMR">1.500</InstdAmt></CdtTrfTxInf>`},
+			{"USD", "9999999999999999.99", `<CdtTrfTxInf><InstdAmt Ccy="USD">9999999999999999.99</InstdAmt></CdtTrfTxInf>`},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.a)
+			got, err := xml.Marshal(iso20022Payment{InstdAmt: ISO20022Amount{Amount: a}})
+			if err != nil {
+				t.Errorf("xml.Marshal(%q) failed: %v", a, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("xml.Marshal(%q) = %s, want %s", a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, a string
+		}{
+			"negative":   {"USD", "-1.00"},
+			"scale":      {"USD", "1.001"},
+			"precision":  {"USD", "99999999999999999.99"},
+			"scale zero": {"JPY", "1.5"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.a)
+				_, err := xml.Marshal(iso20022Payment{InstdAmt: ISO20022Amount{Amount: a}})
+				if err == nil {
+					t.Errorf("xml.Marshal(%q) did not fail", a)
+				}
+			})
+		}
+	})
+}
+
+func TestISO20022Amount_UnmarshalXML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, m, want string
+		}{
+			{`<CdtTrfTxInf><InstdAmt Ccy="USD">1234.56</InstdAmt></CdtTrfTxInf>`, "USD", "1234.56"},
+			{`<CdtTrfTxInf><InstdAmt Ccy="USD">1234.5</InstdAmt></CdtTrfTxInf>`, "USD", "1234.50"},
+			{`<CdtTrfTxInf><InstdAmt Ccy="USD">1234</InstdAmt></CdtTrfTxInf>`, "USD", "1234.00"},
+			{`<CdtTrfTxInf><InstdAmt Ccy="USD"> 0.00 </InstdAmt></CdtTrfTxInf>`, "USD", "0.00"},
+			{`<CdtTrfTxInf><InstdAmt Ccy="JPY">1000</InstdAmt></CdtTrfTxInf>`, "JPY", "1000"},
+			{`<CdtTrfTxInf><InstdAmt Ccy="OMR">0001.125</InstdAmt></CdtTrfTxInf>`, "OMR", "1.125"},
+		}
+		for _, tt := range tests {
+			var got iso20022Payment
+			err := xml.Unmarshal([]byte(tt.s), &got)
+			if err != nil {
+				t.Errorf("xml.Unmarshal(%s) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got.InstdAmt.Amount != want {
+				t.Errorf("xml.Unmarshal(%s) = %q, want %q", tt.s, got.InstdAmt.Amount, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`<CdtTrfTxInf><InstdAmt>1234.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="usd">1234.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="840">1234.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="ABC">1234.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">-1234.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">+1234.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">1234,56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">1.2e3</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">.56</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">1.2.3</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD"></InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">1234.567</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="JPY">1000.5</InstdAmt></CdtTrfTxInf>`,
+			`<CdtTrfTxInf><InstdAmt Ccy="USD">99999999999999999.99</InstdAmt></CdtTrfTxInf>`,
+		}
+		for _, s := range tests {
+			var got iso20022Payment
+			err := xml.Unmarshal([]byte(s), &got)
+			if err == nil {
+				t.Errorf("xml.Unmarshal(%s) did not fail", s)
+			}
+		}
+	})
+}
+
+func TestAmount_SWIFTAmount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, a, want string
+		}{
+			{"USD", "1234.56", "USD1234,56"},
+			{"USD", "1234.5", "USD1234,50"},
+			{"USD", "1234.5000", "USD1234,50"},
+			{"USD", "0", "USD0,00"},
+			{"JPY", "1000", "JPY1000,"},
+			{"OMR", "1.5", "OMR1,500"},
+			{"USD", "999999999999.99", "USD999999999999,99"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.m, tt.a)
+			got, err := a.SWIFTAmount()
+			if err != nil {
+				t.Errorf("%q.SWIFTAmount() failed: %v", a, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.SWIFTAmount() = %q, want %q", a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, a string
+		}{
+			"negative": {"USD", "-1.00"},
+			"scale":    {"USD", "1.001"},
+			"length":   {"USD", "9999999999999.99"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.m, tt.a)
+				_, err := a.SWIFTAmount()
+				if err == nil {
+					t.Errorf("%q.SWIFTAmount() did not fail", a)
+				}
+			})
+		}
+	})
+}
+
+func TestParseSWIFTAmount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, m, want string
+		}{
+			{"USD1234,56", "USD", "1234.56"},
+			{"USD1234,5", "USD", "1234.50"},
+			{"USD1234,", "USD", "1234.00"},
+			{"USD0,", "USD", "0.00"},
+			{"JPY1000,", "JPY", "1000"},
+			{"OMR1,125", "OMR", "1.125"},
+			{"EUR00012,30", "EUR", "12.30"},
+			{"USD999999999999,99", "USD", "999999999999.99"},
+		}
+		for _, tt := range tests {
+			got, err := ParseSWIFTAmount(tt.s)
+			if err != nil {
+				t.Errorf("ParseSWIFTAmount(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("ParseSWIFTAmount(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"",
+			"US",
+			"usd1234,56",
+			"ABC1234,56",
+			"USD",
+			"USD1234",
+			"USD1234.56",
+			"USD,56",
+			"USD-1234,56",
+			"USD1,234,56",
+			"USD 1234,56",
+			"USD1234,567",
+			"JPY1000,5",
+			"USD9999999999999,99",
+			"230915USD1234,56",
+		}
+		for _, s := range tests {
+			_, err := ParseSWIFTAmount(s)
+			if err == nil {
+				t.Errorf("ParseSWIFTAmount(%q) did not fail", s)
+			}
+		}
+	})
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		p, err := NewProvider("Acme", map[string]int{"HUF": 0, "isk": 2})
+		if err != nil {
+			t.Fatalf("NewProvider() failed: %v", err)
+		}
+		tests := []struct {
+			curr Currency
+			want int
+		}{
+			{HUF, 0},
+			{ISK, 2},
+			{USD, 2},
+			{JPY, 0},
+			{OMR, 3},
+		}
+		for _, tt := range tests {
+			if got := p.Scale(tt.curr); got != tt.want {
+				t.Errorf("%v.Scale(%v) = %v, want %v", p, tt.curr, got, tt.want)
+			}
+		}
+		if got, want := p.Name(), "Acme"; got != want {
+			t.Errorf("Name() = %q, want %q", got, want)
+		}
+		if got, want := (Provider{}).Scale(ISK), 0; got != want {
+			t.Errorf("Provider{}.Scale(%v) = %v, want %v", ISK, got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]map[string]int{
+			"unknown currency": {"UUU": 2},
+			"negative scale":   {"USD": -1},
+			"large scale":      {"USD": 20},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewProvider("Acme", tt)
+				if err == nil {
+					t.Errorf("NewProvider(%v) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_ProviderUnits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+			mode         RoundingMode
+			want         int64
+		}{
+			{"USD", "123.45", HalfEven, 12345},
+			{"USD", "-123.45", HalfEven, -12345},
+			{"USD", "0.005", HalfEven, 0},
+			{"USD", "0.005", HalfUp, 1},
+			{"JPY", "500", HalfEven, 500},
+			{"ISK", "500", HalfEven, 50000},
+			{"ISK", "-500.5", HalfEven, -50050},
+			{"UGX", "1000", HalfEven, 100000},
+			{"MGA", "1000.00", HalfEven, 1000},
+			{"MGA", "1000.50", HalfEven, 1000},
+			{"MGA", "1000.50", HalfUp, 1001},
+			{"MGA", "1000.50", Floor, 1000},
+			{"HUF", "100.00", HalfEven, 10000},
+			{"KWD", "1.234", HalfEven, 1234},
+			{"USD", "92233720368547758.07", HalfEven, 9223372036854775807},
+			{"USD", "-92233720368547758.08", HalfEven, -9223372036854775808},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.amount)
+			got, err := a.ProviderUnits(Stripe, tt.mode)
+			if err != nil {
+				t.Errorf("%q.ProviderUnits(%v, %v) failed: %v", a, Stripe, tt.mode, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.ProviderUnits(%v, %v) = %v, want %v", a, Stripe, tt.mode, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+		}{
+			{"USD", "92233720368547758.08"},
+			{"USD", "-92233720368547758.09"},
+			{"ISK", "99999999999999999"},
+			{"ISK", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.amount)
+			_, err := a.ProviderUnits(Stripe)
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%q.ProviderUnits(%v) = %v, want %v", a, Stripe, err, ErrOverflow)
+			}
+		}
+	})
+}
+
+func TestNewAmountFromProviderUnits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr  string
+			units int64
+			want  string
+		}{
+			{"USD", 12345, "USD 123.45"},
+			{"USD", -1, "USD -0.01"},
+			{"JPY", 500, "JPY 500"},
+			{"ISK", 50000, "ISK 500"},
+			{"ISK", 50050, "ISK 500.5"},
+			{"UGX", 100000, "UGX 1000"},
+			{"MGA", 1000, "MGA 1000.00"},
+			{"isk", 100, "ISK 1"},
+			{"USD", math.MaxInt64, "USD 92233720368547758.07"},
+		}
+		for _, tt := range tests {
+			got, err := NewAmountFromProviderUnits(Stripe, tt.curr, tt.units)
+			if err != nil {
+				t.Errorf("NewAmountFromProviderUnits(%v, %q, %v) failed: %v", Stripe, tt.curr, tt.units, err)
+				continue
+			}
+			if want := mustParseSQLAmount(t, tt.want); got != want {
+				t.Errorf("NewAmountFromProviderUnits(%v, %q, %v) = %q, want %q", Stripe, tt.curr, tt.units, got, want)
+			}
+			// Round trip
+			units, err := got.ProviderUnits(Stripe)
+			if err != nil {
+				t.Errorf("%q.ProviderUnits(%v) failed: %v", got, Stripe, err)
+				continue
+			}
+			if units != tt.units {
+				t.Errorf("%q.ProviderUnits(%v) = %v, want %v", got, Stripe, units, tt.units)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		p := MustNewProvider("Acme", map[string]int{"USD": 0})
+		tests := []struct {
+			p     Provider
+			curr  string
+			units int64
+		}{
+			{Stripe, "UUU", 1},
+			{p, "USD", math.MaxInt64},
+		}
+		for _, tt := range tests {
+			_, err := NewAmountFromProviderUnits(tt.p, tt.curr, tt.units)
+			if err == nil {
+				t.Errorf("NewAmountFromProviderUnits(%v, %q, %v) did not fail", tt.p, tt.curr, tt.units)
+			}
+		}
+	})
+}
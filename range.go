@@ -0,0 +1,211 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Range represents a closed interval of amounts [min, max] denominated
+// in a single currency, such as a pricing tier, a minimum top-up, or
+// a maximum withdrawal.
+// The zero value is the range [XXX 0, XXX 0].
+type Range struct {
+	min, max Amount
+}
+
+// NewRange returns a range with the given bounds.
+// Both bounds are included in the range.
+//
+// NewRange returns an error if:
+//   - amounts are denominated in different currencies;
+//   - min is greater than max numerically.
+//
+//nolint:revive
+func NewRange(min, max Amount) (Range, error) {
+	switch cmp, err := min.Cmp(max); {
+	case err != nil:
+		return Range{}, fmt.Errorf("creating range: %w", err)
+	case cmp > 0: // min > max
+		return Range{}, fmt.Errorf("creating range: %v is greater than %v", min, max)
+	}
+	return Range{min: min, max: max}, nil
+}
+
+// MustNewRange is like [NewRange] but panics if the range cannot be created.
+// This function simplifies safe initialization of global variables holding ranges.
+//
+//nolint:revive
+func MustNewRange(min, max Amount) Range {
+	r, err := NewRange(min, max)
+	if err != nil {
+		panic(fmt.Sprintf("NewRange(%v, %v) failed: %v", min, max, err))
+	}
+	return r
+}
+
+// Min returns the lower bound of the range.
+func (r Range) Min() Amount {
+	return r.min
+}
+
+// Max returns the upper bound of the range.
+func (r Range) Max() Amount {
+	return r.max
+}
+
+// Curr returns the currency of the range.
+func (r Range) Curr() Currency {
+	return r.min.Curr()
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the range, for example "[USD 1.00, USD 5.00]".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (r Range) String() string {
+	text := make([]byte, 0, 48)
+	text = append(text, '[')
+	text = r.min.append(text)
+	text = append(text, ", "...)
+	text = r.max.append(text)
+	text = append(text, ']')
+	return string(text)
+}
+
+// Contains returns true if min <= a <= max numerically.
+//
+// Contains returns an error if the amount and the range are denominated
+// in different currencies.
+func (r Range) Contains(a Amount) (bool, error) {
+	ok, err := r.contains(a)
+	if err != nil {
+		return false, fmt.Errorf("checking if %v contains [%v]: %w", r, a, err)
+	}
+	return ok, nil
+}
+
+func (r Range) contains(a Amount) (bool, error) {
+	if !r.min.SameCurr(a) {
+		return false, ErrCurrencyMismatch
+	}
+	d, e, f := r.min.Decimal(), a.Decimal(), r.max.Decimal()
+	return d.Cmp(e) <= 0 && e.Cmp(f) <= 0, nil
+}
+
+// Clamp returns the closest amount within the range:
+//
+//	min if a < min
+//	max if a > max
+//	  a otherwise
+//
+// See also method [Amount.Clamp].
+//
+// Clamp returns an error if the amount and the range are denominated
+// in different currencies.
+func (r Range) Clamp(a Amount) (Amount, error) {
+	return a.Clamp(r.min, r.max)
+}
+
+// Overlaps returns true if ranges have at least one amount in common.
+// See also method [Range.Intersect].
+//
+// Overlaps returns an error if ranges are denominated in different currencies.
+func (r Range) Overlaps(s Range) (bool, error) {
+	if r.Curr() != s.Curr() {
+		return false, fmt.Errorf("checking if %v overlaps %v: %w", r, s, ErrCurrencyMismatch)
+	}
+	return r.overlaps(s), nil
+}
+
+func (r Range) overlaps(s Range) bool {
+	return r.min.Decimal().Cmp(s.max.Decimal()) <= 0 &&
+		s.min.Decimal().Cmp(r.max.Decimal()) <= 0
+}
+
+// Intersect returns the range of amounts common to both ranges.
+// See also method [Range.Overlaps].
+//
+// Intersect returns an error if:
+//   - ranges are denominated in different currencies;
+//   - ranges do not overlap.
+func (r Range) Intersect(s Range) (Range, error) {
+	t, err := r.intersect(s)
+	if err != nil {
+		return Range{}, fmt.Errorf("intersecting %v and %v: %w", r, s, err)
+	}
+	return t, nil
+}
+
+func (r Range) intersect(s Range) (Range, error) {
+	if r.Curr() != s.Curr() {
+		return Range{}, ErrCurrencyMismatch
+	}
+	if !r.overlaps(s) {
+		return Range{}, fmt.Errorf("ranges do not overlap")
+	}
+	min, err := r.min.Max(s.min)
+	if err != nil {
+		return Range{}, err
+	}
+	max, err := r.max.Min(s.max)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{min: min, max: max}, nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The range must be an object with the bounds as strings or numbers and
+// the currency as a 3-letter code, for example
+// {"currency":"USD","min":"1.00","max":"5.00"}.
+// See also constructor [NewRange].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (r *Range) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var err error
+	var v struct {
+		Currency string      `json:"currency"`
+		Min      json.Number `json:"min"`
+		Max      json.Number `json:"max"`
+	}
+	if err = json.Unmarshal(data, &v); err == nil {
+		*r, err = parseRange(v.Currency, string(v.Min), string(v.Max))
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Range{}, err)
+	}
+	return nil
+}
+
+func parseRange(curr, min, max string) (Range, error) {
+	a, err := ParseAmount(curr, min)
+	if err != nil {
+		return Range{}, err
+	}
+	b, err := ParseAmount(curr, max)
+	if err != nil {
+		return Range{}, err
+	}
+	return NewRange(a, b)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// MarshalJSON always returns an object with the bounds as strings and
+// the currency as a 3-letter code, for example
+// {"currency":"USD","min":"1.00","max":"5.00"}.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (r Range) MarshalJSON() ([]byte, error) {
+	text := make([]byte, 0, 64)
+	text = append(text, `{"currency":"`...)
+	text = append(text, r.Curr().Code()...)
+	text = append(text, `","min":"`...)
+	text, _ = r.min.Decimal().AppendText(text) // Decimal.AppendText is always successful
+	text = append(text, `","max":"`...)
+	text, _ = r.max.Decimal().AppendText(text) // Decimal.AppendText is always successful
+	text = append(text, `"}`...)
+	return text, nil
+}
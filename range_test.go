@@ -0,0 +1,303 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, min, max string
+		}{
+			{"USD", "0", "0"},
+			{"USD", "0.00", "0"},
+			{"USD", "0", "0.00"},
+			{"USD", "-1", "1"},
+			{"USD", "1.00", "5.00"},
+			{"JPY", "100", "100000"},
+		}
+		for _, tt := range tests {
+			min := MustParseAmount(tt.m, tt.min)
+			max := MustParseAmount(tt.m, tt.max)
+			got, err := NewRange(min, max)
+			if err != nil {
+				t.Errorf("NewRange(%q, %q) failed: %v", min, max, err)
+				continue
+			}
+			if got.Min() != min || got.Max() != max {
+				t.Errorf("NewRange(%q, %q) = %v, want [%v, %v]", min, max, got, min, max)
+			}
+			if got.Curr() != min.Curr() {
+				t.Errorf("NewRange(%q, %q).Curr() = %v, want %v", min, max, got.Curr(), min.Curr())
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			currmin, min, currmax, max string
+		}{
+			"invalid range 1":   {"USD", "1", "USD", "0"},
+			"invalid range 2":   {"USD", "-1", "USD", "-2"},
+			"currency mismatch": {"USD", "0", "EUR", "1"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				min := MustParseAmount(tt.currmin, tt.min)
+				max := MustParseAmount(tt.currmax, tt.max)
+				_, err := NewRange(min, max)
+				if err == nil {
+					t.Errorf("NewRange(%q, %q) did not fail", min, max)
+				}
+			})
+		}
+	})
+}
+
+func TestRange_String(t *testing.T) {
+	tests := []struct {
+		m, min, max, want string
+	}{
+		{"USD", "1.00", "5.00", "[USD 1.00, USD 5.00]"},
+		{"JPY", "-1", "1", "[JPY -1, JPY 1]"},
+	}
+	for _, tt := range tests {
+		r := MustNewRange(MustParseAmount(tt.m, tt.min), MustParseAmount(tt.m, tt.max))
+		got := r.String()
+		if got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", r, got, tt.want)
+		}
+	}
+	got := Range{}.String()
+	if want := "[XXX 0, XXX 0]"; got != want {
+		t.Errorf("Range{}.String() = %q, want %q", got, want)
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, min, max, d string
+			want           bool
+		}{
+			{"USD", "1.00", "5.00", "0.99", false},
+			{"USD", "1.00", "5.00", "1", true},
+			{"USD", "1.00", "5.00", "1.000", true},
+			{"USD", "1.00", "5.00", "3.14", true},
+			{"USD", "1.00", "5.00", "5.000", true},
+			{"USD", "1.00", "5.00", "5.001", false},
+			{"USD", "0", "0", "0.00", true},
+			{"USD", "-5", "-1", "0", false},
+		}
+		for _, tt := range tests {
+			r := MustNewRange(MustParseAmount(tt.m, tt.min), MustParseAmount(tt.m, tt.max))
+			a := MustParseAmount(tt.m, tt.d)
+			got, err := r.Contains(a)
+			if err != nil {
+				t.Errorf("%v.Contains(%q) failed: %v", r, a, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%v.Contains(%q) = %t, want %t", r, a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := MustNewRange(MustParseAmount("USD", "1"), MustParseAmount("USD", "5"))
+		a := MustParseAmount("EUR", "3")
+		_, err := r.Contains(a)
+		if err == nil {
+			t.Errorf("%v.Contains(%q) did not fail", r, a)
+		}
+	})
+}
+
+func TestRange_Clamp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, min, max, d, want string
+		}{
+			{"USD", "1.00", "5.00", "0.99", "1.00"},
+			{"USD", "1.00", "5.00", "3.14", "3.14"},
+			{"USD", "1.00", "5.00", "5.01", "5.00"},
+			{"USD", "-5", "-1", "0", "-1"},
+		}
+		for _, tt := range tests {
+			r := MustNewRange(MustParseAmount(tt.m, tt.min), MustParseAmount(tt.m, tt.max))
+			a := MustParseAmount(tt.m, tt.d)
+			got, err := r.Clamp(a)
+			if err != nil {
+				t.Errorf("%v.Clamp(%q) failed: %v", r, a, err)
+				continue
+			}
+			want := MustParseAmount(tt.m, tt.want)
+			if got != want {
+				t.Errorf("%v.Clamp(%q) = %q, want %q", r, a, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := MustNewRange(MustParseAmount("USD", "1"), MustParseAmount("USD", "5"))
+		a := MustParseAmount("EUR", "3")
+		_, err := r.Clamp(a)
+		if err == nil {
+			t.Errorf("%v.Clamp(%q) did not fail", r, a)
+		}
+	})
+}
+
+func TestRange_Overlaps(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, rmin, rmax, smin, smax string
+			want                      bool
+		}{
+			{"USD", "1", "5", "2", "3", true},
+			{"USD", "1", "5", "0", "1", true},
+			{"USD", "1", "5", "5.00", "10", true},
+			{"USD", "1", "5", "5.01", "10", false},
+			{"USD", "1", "5", "-10", "0.99", false},
+			{"USD", "1", "5", "0", "10", true},
+		}
+		for _, tt := range tests {
+			r := MustNewRange(MustParseAmount(tt.m, tt.rmin), MustParseAmount(tt.m, tt.rmax))
+			s := MustNewRange(MustParseAmount(tt.m, tt.smin), MustParseAmount(tt.m, tt.smax))
+			got, err := r.Overlaps(s)
+			if err != nil {
+				t.Errorf("%v.Overlaps(%v) failed: %v", r, s, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%v.Overlaps(%v) = %t, want %t", r, s, got, tt.want)
+			}
+			got, err = s.Overlaps(r)
+			if err != nil {
+				t.Errorf("%v.Overlaps(%v) failed: %v", s, r, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%v.Overlaps(%v) = %t, want %t", s, r, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := MustNewRange(MustParseAmount("USD", "1"), MustParseAmount("USD", "5"))
+		s := MustNewRange(MustParseAmount("EUR", "1"), MustParseAmount("EUR", "5"))
+		_, err := r.Overlaps(s)
+		if err == nil {
+			t.Errorf("%v.Overlaps(%v) did not fail", r, s)
+		}
+	})
+}
+
+func TestRange_Intersect(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, rmin, rmax, smin, smax, wantMin, wantMax string
+		}{
+			{"USD", "1", "5", "2", "3", "2", "3"},
+			{"USD", "1", "5", "0", "10", "1", "5"},
+			{"USD", "1", "5", "3", "10", "3", "5"},
+			{"USD", "1", "5", "5", "10", "5", "5"},
+			{"USD", "1", "5", "-10", "1", "1", "1"},
+		}
+		for _, tt := range tests {
+			r := MustNewRange(MustParseAmount(tt.m, tt.rmin), MustParseAmount(tt.m, tt.rmax))
+			s := MustNewRange(MustParseAmount(tt.m, tt.smin), MustParseAmount(tt.m, tt.smax))
+			got, err := r.Intersect(s)
+			if err != nil {
+				t.Errorf("%v.Intersect(%v) failed: %v", r, s, err)
+				continue
+			}
+			want := MustNewRange(MustParseAmount(tt.m, tt.wantMin), MustParseAmount(tt.m, tt.wantMax))
+			if got != want {
+				t.Errorf("%v.Intersect(%v) = %v, want %v", r, s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			currr, rmin, rmax, currs, smin, smax string
+		}{
+			"no overlap":        {"USD", "1", "5", "USD", "6", "10"},
+			"currency mismatch": {"USD", "1", "5", "EUR", "1", "5"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := MustNewRange(MustParseAmount(tt.currr, tt.rmin), MustParseAmount(tt.currr, tt.rmax))
+				s := MustNewRange(MustParseAmount(tt.currs, tt.smin), MustParseAmount(tt.currs, tt.smax))
+				_, err := r.Intersect(s)
+				if err == nil {
+					t.Errorf("%v.Intersect(%v) did not fail", r, s)
+				}
+			})
+		}
+	})
+}
+
+func TestRange_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		m, min, max, want string
+	}{
+		{"USD", "1.00", "5.00", `{"currency":"USD","min":"1.00","max":"5.00"}`},
+		{"JPY", "-1", "1", `{"currency":"JPY","min":"-1","max":"1"}`},
+	}
+	for _, tt := range tests {
+		r := MustNewRange(MustParseAmount(tt.m, tt.min), MustParseAmount(tt.m, tt.max))
+		got, err := json.Marshal(r)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", r, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", r, got, tt.want)
+		}
+	}
+}
+
+func TestRange_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, m, wantMin, wantMax string
+		}{
+			{`{"currency":"USD","min":"1.00","max":"5.00"}`, "USD", "1.00", "5.00"},
+			{`{"currency":"USD","min":1.00,"max":5.00}`, "USD", "1.00", "5.00"},
+			{`{"max":"1","min":"-1","currency":"JPY"}`, "JPY", "-1", "1"},
+		}
+		for _, tt := range tests {
+			var got Range
+			err := json.Unmarshal([]byte(tt.s), &got)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustNewRange(MustParseAmount(tt.m, tt.wantMin), MustParseAmount(tt.m, tt.wantMax))
+			if got != want {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`{"currency":"USD","min":"5.00","max":"1.00"}`,
+			`{"currency":"ABC","min":"1.00","max":"5.00"}`,
+			`{"currency":"USD","min":"abc","max":"5.00"}`,
+			`{"currency":"USD","min":"1.00"}`,
+			`"USD 1.00"`,
+			`[]`,
+		}
+		for _, s := range tests {
+			var got Range
+			err := json.Unmarshal([]byte(s), &got)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", s)
+			}
+		}
+	})
+}
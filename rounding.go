@@ -0,0 +1,145 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// RoundingMode specifies how to round a result that cannot be represented
+// exactly with the required number of digits after the decimal point.
+// The zero value is [HalfEven].
+type RoundingMode int8
+
+const (
+	// HalfEven rounds to the nearest neighbor, and if both neighbors are
+	// equidistant, to the even neighbor ([banker's rounding]).
+	//
+	// [banker's rounding]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest neighbor, and if both neighbors are
+	// equidistant, away from zero ([commercial rounding]).
+	//
+	// [commercial rounding]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_away_from_zero
+	HalfUp
+	// HalfDown rounds to the nearest neighbor, and if both neighbors are
+	// equidistant, toward zero.
+	HalfDown
+	// Ceiling rounds toward positive infinity.
+	Ceiling
+	// Floor rounds toward negative infinity.
+	Floor
+	// Truncate rounds toward zero.
+	Truncate
+)
+
+// DefaultRoundingMode is the rounding mode used by [Amount.RoundWith],
+// [Amount.MulRound], [Amount.QuoRound], and [ExchangeRate.ConvRound]
+// when the rounding mode is not specified explicitly.
+// It is intended to be set once during program initialization, for example
+// to [HalfUp] when required by a tax authority or a card network.
+// Changing it while other goroutines are performing operations is a data race.
+var DefaultRoundingMode = HalfEven
+
+// String implements the [fmt.Stringer] interface and returns the name of
+// the rounding mode.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (r RoundingMode) String() string {
+	switch r {
+	case HalfEven:
+		return "HalfEven"
+	case HalfUp:
+		return "HalfUp"
+	case HalfDown:
+		return "HalfDown"
+	case Ceiling:
+		return "Ceiling"
+	case Floor:
+		return "Floor"
+	case Truncate:
+		return "Truncate"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int8(r))
+	}
+}
+
+// roundingMode returns the first of the given modes, or [DefaultRoundingMode]
+// if no modes are given.
+func roundingMode(modes []RoundingMode) RoundingMode {
+	if len(modes) == 0 {
+		return DefaultRoundingMode
+	}
+	return modes[0]
+}
+
+// round returns a decimal rounded to the specified number of digits after
+// the decimal point using the rounding mode r.
+// If the given scale is negative, it is redefined to zero.
+func (r RoundingMode) round(d decimal.Decimal, scale int) decimal.Decimal {
+	switch r {
+	case HalfUp, HalfDown:
+		// skip
+	case Ceiling:
+		return d.Ceil(scale)
+	case Floor:
+		return d.Floor(scale)
+	case Truncate:
+		return d.Trunc(scale)
+	default:
+		return d.Round(scale)
+	}
+
+	scale = max(scale, 0)
+	if d.Scale() <= scale {
+		return d
+	}
+	t := d.Trunc(scale)
+	rem, _ := d.Sub(t) // rem is always less than t.ULP(), so no overflow is possible
+	half := decimal.MustNew(5, scale+1)
+	switch cmp := rem.CmpAbs(half); {
+	case cmp < 0, cmp == 0 && r == HalfDown:
+		return t
+	default:
+		u, _ := t.Add(t.ULP().CopySign(d)) // t has less than 19 digits, so no overflow is possible
+		return u
+	}
+}
+
+// roundToMultiple returns a decimal rounded to a multiple of the positive
+// unit u using the rounding mode r.
+func (r RoundingMode) roundToMultiple(d, u decimal.Decimal) (decimal.Decimal, error) {
+	q, rem, err := d.QuoRem(u)
+	if err != nil {
+		return decimal.Decimal{}, decimalErr(err)
+	}
+	if rem.IsZero() {
+		return d, nil
+	}
+
+	// The quotient is adjusted by rounding a surrogate number that has
+	// the same parity as the quotient and the same position between
+	// neighboring integers as d / u.
+	twice, err := rem.Abs().Mul(decimal.Two)
+	if err != nil {
+		return decimal.Decimal{}, decimalErr(err)
+	}
+	var frac decimal.Decimal
+	switch twice.Cmp(u) {
+	case -1:
+		frac = decimal.MustNew(25, 2)
+	case 0:
+		frac = decimal.MustNew(5, 1)
+	default:
+		frac = decimal.MustNew(75, 2)
+	}
+	p := decimal.MustNew(int64(q.Trunc(0).Coef()%2), 0).CopySign(d) //nolint:gosec
+	s, _ := p.Add(frac.CopySign(d))                                 // |s| < 2, so no overflow is possible
+	inc, _ := r.round(s, 0).Sub(p)                                  // |inc| <= 1, so no overflow is possible
+	q, err = q.Add(inc)
+	if err != nil {
+		return decimal.Decimal{}, decimalErr(err)
+	}
+	d, err = q.Mul(u)
+	return d, decimalErr(err)
+}
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestRoundingMode_String(t *testing.T) {
+	tests := []struct {
+		r    RoundingMode
+		want string
+	}{
+		{HalfEven, "HalfEven"},
+		{HalfUp, "HalfUp"},
+		{HalfDown, "HalfDown"},
+		{Ceiling, "Ceiling"},
+		{Floor, "Floor"},
+		{Truncate, "Truncate"},
+		{RoundingMode(-1), "RoundingMode(-1)"},
+	}
+	for _, tt := range tests {
+		got := tt.r.String()
+		if got != tt.want {
+			t.Errorf("RoundingMode(%d).String() = %q, want %q", int8(tt.r), got, tt.want)
+		}
+	}
+}
+
+func TestRoundingMode_round(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  [6]string // HalfEven, HalfUp, HalfDown, Ceiling, Floor, Truncate
+	}{
+		{"5.5", 0, [6]string{"6", "6", "5", "6", "5", "5"}},
+		{"2.5", 0, [6]string{"2", "3", "2", "3", "2", "2"}},
+		{"1.6", 0, [6]string{"2", "2", "2", "2", "1", "1"}},
+		{"1.1", 0, [6]string{"1", "1", "1", "2", "1", "1"}},
+		{"1.0", 0, [6]string{"1", "1", "1", "1", "1", "1"}},
+		{"-1.0", 0, [6]string{"-1", "-1", "-1", "-1", "-1", "-1"}},
+		{"-1.1", 0, [6]string{"-1", "-1", "-1", "-1", "-2", "-1"}},
+		{"-1.6", 0, [6]string{"-2", "-2", "-2", "-1", "-2", "-1"}},
+		{"-2.5", 0, [6]string{"-2", "-3", "-2", "-2", "-3", "-2"}},
+		{"-5.5", 0, [6]string{"-6", "-6", "-5", "-5", "-6", "-5"}},
+		{"0.125", 2, [6]string{"0.12", "0.13", "0.12", "0.13", "0.12", "0.12"}},
+		{"0.135", 2, [6]string{"0.14", "0.14", "0.13", "0.14", "0.13", "0.13"}},
+		{"0.1251", 2, [6]string{"0.13", "0.13", "0.13", "0.13", "0.12", "0.12"}},
+		{"-0.005", 2, [6]string{"0.00", "-0.01", "0.00", "0.00", "-0.01", "0.00"}},
+		{"0.005", 2, [6]string{"0.00", "0.01", "0.00", "0.01", "0.00", "0.00"}},
+		{"0.12", 2, [6]string{"0.12", "0.12", "0.12", "0.12", "0.12", "0.12"}},
+		{"0.1", 2, [6]string{"0.1", "0.1", "0.1", "0.1", "0.1", "0.1"}},
+		{"1.5", -1, [6]string{"2", "2", "1", "2", "1", "1"}},
+		{"9.999999999999999999", 17, [6]string{"10.00000000000000000", "10.00000000000000000", "10.00000000000000000", "10.00000000000000000", "9.99999999999999999", "9.99999999999999999"}},
+		{"0.9999999999999999995", 18, [6]string{"1.000000000000000000", "1.000000000000000000", "0.999999999999999999", "1.000000000000000000", "0.999999999999999999", "0.999999999999999999"}},
+	}
+	modes := [...]RoundingMode{HalfEven, HalfUp, HalfDown, Ceiling, Floor, Truncate}
+	for _, tt := range tests {
+		d := decimal.MustParse(tt.d)
+		for i, r := range modes {
+			got := r.round(d, tt.scale)
+			want := decimal.MustParse(tt.want[i])
+			if got != want {
+				t.Errorf("%v.round(%v, %v) = %v, want %v", r, d, tt.scale, got, want)
+			}
+		}
+	}
+}
+
+func TestRoundingMode_roundToMultiple(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, u string
+			want [6]string // HalfEven, HalfUp, HalfDown, Ceiling, Floor, Truncate
+		}{
+			{"1.10", "0.05", [6]string{"1.10", "1.10", "1.10", "1.10", "1.10", "1.10"}},
+			{"1.12", "0.05", [6]string{"1.10", "1.10", "1.10", "1.15", "1.10", "1.10"}},
+			{"1.125", "0.05", [6]string{"1.10", "1.15", "1.10", "1.15", "1.10", "1.10"}},
+			{"1.13", "0.05", [6]string{"1.15", "1.15", "1.15", "1.15", "1.10", "1.10"}},
+			{"1.175", "0.05", [6]string{"1.20", "1.20", "1.15", "1.20", "1.15", "1.15"}},
+			{"-1.12", "0.05", [6]string{"-1.10", "-1.10", "-1.10", "-1.10", "-1.15", "-1.10"}},
+			{"-1.125", "0.05", [6]string{"-1.10", "-1.15", "-1.10", "-1.10", "-1.15", "-1.10"}},
+			{"-1.175", "0.05", [6]string{"-1.20", "-1.20", "-1.15", "-1.15", "-1.20", "-1.15"}},
+			{"2.5", "1", [6]string{"2", "3", "2", "3", "2", "2"}},
+			{"0.24", "0.50", [6]string{"0.00", "0.00", "0.00", "0.50", "0.00", "0.00"}},
+			{"0.25", "0.50", [6]string{"0.00", "0.50", "0.00", "0.50", "0.00", "0.00"}},
+			{"0.75", "0.50", [6]string{"1.00", "1.00", "0.50", "1.00", "0.50", "0.50"}},
+			{"0.10", "0.03", [6]string{"0.09", "0.09", "0.09", "0.12", "0.09", "0.09"}},
+		}
+		modes := [...]RoundingMode{HalfEven, HalfUp, HalfDown, Ceiling, Floor, Truncate}
+		for _, tt := range tests {
+			d := decimal.MustParse(tt.d)
+			u := decimal.MustParse(tt.u)
+			for i, r := range modes {
+				got, err := r.roundToMultiple(d, u)
+				if err != nil {
+					t.Errorf("%v.roundToMultiple(%v, %v) failed: %v", r, d, u, err)
+					continue
+				}
+				want := decimal.MustParse(tt.want[i])
+				if got != want {
+					t.Errorf("%v.roundToMultiple(%v, %v) = %v, want %v", r, d, u, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d, u string
+		}{
+			{"1", "0"},
+			{"9999999999999999999", "0.01"},
+		}
+		for _, tt := range tests {
+			d := decimal.MustParse(tt.d)
+			u := decimal.MustParse(tt.u)
+			_, err := HalfEven.roundToMultiple(d, u)
+			if err == nil {
+				t.Errorf("HalfEven.roundToMultiple(%v, %v) did not fail", d, u)
+			}
+		}
+	})
+}
+
+func TestDefaultRoundingMode(t *testing.T) {
+	defer func(r RoundingMode) { DefaultRoundingMode = r }(DefaultRoundingMode)
+
+	a := MustParseAmount("USD", "2.125")
+	if got, want := a.RoundWith(2), MustParseAmount("USD", "2.12"); got != want {
+		t.Errorf("%q.RoundWith(2) = %q, want %q", a, got, want)
+	}
+	DefaultRoundingMode = HalfUp
+	if got, want := a.RoundWith(2), MustParseAmount("USD", "2.13"); got != want {
+		t.Errorf("%q.RoundWith(2) = %q, want %q", a, got, want)
+	}
+	if got, want := a.RoundWith(2, Floor), MustParseAmount("USD", "2.12"); got != want {
+		t.Errorf("%q.RoundWith(2, Floor) = %q, want %q", a, got, want)
+	}
+}
@@ -3,8 +3,11 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"encoding/csv"
+	"encoding/json"
 	"encoding/xml"
+	"flag"
 	"fmt"
 	"go/format"
 	"io"
@@ -12,6 +15,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -21,33 +25,254 @@ type currency struct {
 	Code  string
 	Num   string
 	Scale string
+	Fund  bool
 }
 
+// source specifies where the currency data is read from.
+var source = flag.String("source", "file", `source of the currency data:
+"file" reads the CSV snapshots in scripts/currency and verifies them against checksums.txt,
+"url" downloads the latest ISO 4217 and CLDR data, updates the snapshots, and pins their checksums`)
+
+// names specifies whether the currency display names are downloaded from
+// the CLDR along with the other locale data.
+var names = flag.Bool("names", true, `download the localized currency display names with -source=url,
+otherwise name_data.csv is emptied and only the English ISO 4217 names are available`)
+
+// verify specifies whether the generated code is only compared with
+// the existing files instead of being written.
+var verify = flag.Bool("verify", false, `regenerate the code in memory and exit with a non-zero status
+if any of the generated files is stale, without writing them`)
+
 func main() {
+	flag.Parse()
+	switch *source {
+	case "file":
+		if err := verifyChecksums(); err != nil {
+			panic(fmt.Errorf("error verifying snapshots: %v", err))
+		}
+	case "url":
+		if *verify {
+			panic(fmt.Errorf("-verify cannot be used with -source=url"))
+		}
+		if err := updateSnapshots(); err != nil {
+			panic(err)
+		}
+	default:
+		panic(fmt.Errorf("unknown source %q, want \"file\" or \"url\"", *source))
+	}
+
+	files, err := generateFiles()
+	if err != nil {
+		panic(err)
+	}
+
+	if *verify {
+		stale, err := staleFiles(files)
+		if err != nil {
+			panic(fmt.Errorf("error verifying generated code: %v", err))
+		}
+		if len(stale) > 0 {
+			fmt.Fprintf(os.Stderr, "generated code is stale, run go generate to update: %v\n", strings.Join(stale, ", "))
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Write the generated Go code to files
+	for _, f := range files {
+		if err := writeToFile(f.name, f.code); err != nil {
+			panic(fmt.Errorf("error writing to file: %v", err))
+		}
+	}
+}
+
+// updateSnapshots downloads the latest ISO 4217 and CLDR data, updates
+// the snapshots, and pins their checksums.
+func updateSnapshots() error {
 	if err := UpdateCurrencyData(); err != nil {
-		panic(fmt.Errorf("error updating currency data: %v", err))
+		return fmt.Errorf("error updating currency data: %v", err)
 	}
+	data, err := readCsvFile(filepath.Join("scripts", "currency", "currency_data.csv"))
+	if err != nil {
+		return fmt.Errorf("error reading CSV file: %v", err)
+	}
+	currs := convertDataToCurrencies(data)
+	if err := UpdateLocaleData(currs); err != nil {
+		return fmt.Errorf("error updating locale data: %v", err)
+	}
+	if err := UpdateHistoricalData(currs); err != nil {
+		return fmt.Errorf("error updating historical currency data: %v", err)
+	}
+	if err := UpdateCashData(currs); err != nil {
+		return fmt.Errorf("error updating cash data: %v", err)
+	}
+	if err := UpdateCountryData(currs); err != nil {
+		return fmt.Errorf("error updating country data: %v", err)
+	}
+	if err := writeChecksums(); err != nil {
+		return fmt.Errorf("error pinning snapshots: %v", err)
+	}
+	return nil
+}
 
+// generatedFile is a Go source file generated from the snapshots.
+type generatedFile struct {
+	name string
+	code []byte
+}
+
+// generateFiles generates the Go code from the snapshots in memory.
+func generateFiles() ([]generatedFile, error) {
 	// Open the input file and read its contents
 	data, err := readCsvFile(filepath.Join("scripts", "currency", "currency_data.csv"))
 	if err != nil {
-		panic(fmt.Errorf("error reading CSV file: %v", err))
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
 	}
 
 	// Convert the CSV records to a list of Currency objects
 	currs := convertDataToCurrencies(data)
 
-	// Generate Go code from the Currency objects using a template
-	code, err := generateGoCode(filepath.Join("scripts", "currency", "currency_data.tmpl"), currs)
+	// Open the input files and read their contents
+	locData, err := readCsvFile(filepath.Join("scripts", "currency", "locale_data.csv"))
 	if err != nil {
-		panic(fmt.Errorf("error generating Go code: %v", err))
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
 	}
+	symData, err := readCsvFile(filepath.Join("scripts", "currency", "symbol_data.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
+	}
+
+	// Convert the CSV records to a list of Locale objects
+	locs := convertDataToLocales(locData, symData)
 
-	// Write the generated Go code to a file
-	err = writeToFile("currency_data.go", code)
+	// Open the input file and read its contents
+	nameData, err := readCsvFile(filepath.Join("scripts", "currency", "name_data.csv"))
 	if err != nil {
-		panic(fmt.Errorf("error writing to file: %v", err))
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
 	}
+
+	// Convert the CSV records to a list of DisplayNames objects
+	dispNames := convertDataToNames(nameData)
+
+	// Open the input file and read its contents
+	histData, err := readCsvFile(filepath.Join("scripts", "currency", "historical_data.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
+	}
+
+	// Convert the CSV records to a list of Currency objects
+	hists := convertDataToCurrencies(histData)
+
+	// Open the input file and read its contents
+	cashData, err := readCsvFile(filepath.Join("scripts", "currency", "cash_data.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
+	}
+
+	// Convert the CSV records to a list of Cash objects
+	cash := convertDataToCash(cashData)
+
+	// Open the input file and read its contents
+	countryData, err := readCsvFile(filepath.Join("scripts", "currency", "country_data.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV file: %v", err)
+	}
+
+	// Convert the CSV records to a list of Country objects
+	countries := convertDataToCountries(countryData)
+
+	// Generate Go code from the objects using templates
+	var files []generatedFile
+	for _, g := range []struct {
+		name string
+		data any
+	}{
+		{"currency_data", currs},
+		{"locale_data", locs},
+		{"name_data", dispNames},
+		{"historical_data", hists},
+		{"cash_data", cash},
+		{"country_data", countries},
+	} {
+		code, err := generateGoCode(filepath.Join("scripts", "currency", g.name+".tmpl"), g.data)
+		if err != nil {
+			return nil, fmt.Errorf("error generating Go code: %v", err)
+		}
+		files = append(files, generatedFile{name: g.name + ".go", code: code})
+	}
+	return files, nil
+}
+
+// staleFiles returns the names of the generated files whose contents differ
+// from the existing files.
+func staleFiles(files []generatedFile) ([]string, error) {
+	var stale []string
+	for _, f := range files {
+		got, err := os.ReadFile(f.name)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if !bytes.Equal(got, f.code) {
+			stale = append(stale, f.name)
+		}
+	}
+	return stale, nil
+}
+
+// snapshots lists the CSV files that hold the currency data downloaded
+// from ISO 4217 and CLDR.
+var snapshots = []string{
+	"cash_data.csv",
+	"country_data.csv",
+	"currency_data.csv",
+	"historical_data.csv",
+	"locale_data.csv",
+	"name_data.csv",
+	"symbol_data.csv",
+}
+
+// checksumFile holds the SHA-256 checksums of the snapshots in the format
+// of the sha256sum utility.
+var checksumFile = filepath.Join("scripts", "currency", "checksums.txt")
+
+// computeChecksums returns the SHA-256 checksums of the snapshots in the format
+// of the sha256sum utility.
+func computeChecksums() ([]byte, error) {
+	var output bytes.Buffer
+	for _, name := range snapshots {
+		data, err := os.ReadFile(filepath.Join("scripts", "currency", name))
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&output, "%x  %s\n", sha256.Sum256(data), name)
+	}
+	return output.Bytes(), nil
+}
+
+// writeChecksums pins the current snapshots by updating checksums.txt.
+func writeChecksums() error {
+	sums, err := computeChecksums()
+	if err != nil {
+		return err
+	}
+	return writeToFile(checksumFile, sums)
+}
+
+// verifyChecksums ensures that the snapshots have not changed since they
+// were pinned, so that the generated code is reproducible.
+func verifyChecksums() error {
+	want, err := os.ReadFile(checksumFile)
+	if err != nil {
+		return err
+	}
+	got, err := computeChecksums()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("snapshots do not match %v, run with -source=url to update them", checksumFile)
+	}
+	return nil
 }
 
 func readCsvFile(filename string) ([][]string, error) {
@@ -96,12 +321,133 @@ func convertDataToCurrencies(data [][]string) []currency {
 			Num:   rec[2],
 			Scale: rec[3],
 		}
+		// Historic currencies do not have the fund flag
+		if len(rec) > 4 {
+			curr.Fund = rec[4] == "true"
+		}
 		currs = append(currs, curr)
 	}
 	return currs
 }
 
-func generateGoCode(filename string, currs []currency) ([]byte, error) {
+type cash struct {
+	Code string
+	Unit string
+}
+
+func convertDataToCash(data [][]string) []cash {
+	res := []cash{}
+	for _, rec := range data {
+		res = append(res, cash{Code: rec[0], Unit: rec[1]})
+	}
+	return res
+}
+
+type country struct {
+	Code      string
+	Countries []string
+}
+
+func convertDataToCountries(data [][]string) []country {
+	res := []country{}
+	for _, rec := range data {
+		res = append(res, country{Code: rec[0], Countries: strings.Fields(rec[1])})
+	}
+	return res
+}
+
+type locale struct {
+	Tag     string
+	Point   string
+	Group   string
+	Prefix  bool
+	Spaced  bool
+	Symbols []symbol
+}
+
+type symbol struct {
+	Code   string
+	Symbol string
+	Narrow string
+}
+
+type locales struct {
+	Locales []locale
+	Symbols []symbol
+}
+
+func convertDataToLocales(locData, symData [][]string) locales {
+	// Group the symbol records by locale
+	syms := map[string][]symbol{}
+	for _, rec := range symData {
+		syms[rec[0]] = append(syms[rec[0]], symbol{Code: rec[1], Symbol: rec[2], Narrow: rec[3]})
+	}
+
+	// Convert the CSV records to Locale objects
+	res := locales{Symbols: syms["en"]}
+	for _, rec := range locData {
+		prefix, spaced := parseCurrencyPattern(rec[3])
+		loc := locale{
+			Tag:    rec[0],
+			Point:  rec[1],
+			Group:  rec[2],
+			Prefix: prefix,
+			Spaced: spaced,
+		}
+		if loc.Tag != "en" {
+			loc.Symbols = syms[loc.Tag]
+		}
+		res.Locales = append(res.Locales, loc)
+	}
+	return res
+}
+
+type displayName struct {
+	Code string
+	Name string
+}
+
+type displayNames struct {
+	Tag   string
+	Names []displayName
+}
+
+func convertDataToNames(data [][]string) []displayNames {
+	// Group the name records by locale, keeping the order of the locales
+	res := []displayNames{}
+	for _, rec := range data {
+		if len(res) == 0 || res[len(res)-1].Tag != rec[0] {
+			res = append(res, displayNames{Tag: rec[0]})
+		}
+		last := &res[len(res)-1]
+		last.Names = append(last.Names, displayName{Code: rec[1], Name: rec[2]})
+	}
+	return res
+}
+
+// parseCurrencyPattern reports whether the currency symbol precedes the number
+// in the CLDR currency pattern, and whether it is separated from the number
+// by a space.
+// Only the positive subpattern is taken into account.
+func parseCurrencyPattern(pattern string) (prefix, spaced bool) {
+	pattern, _, _ = strings.Cut(pattern, ";")
+	sym := strings.Index(pattern, "¤")
+	first, last := strings.IndexAny(pattern, "#0"), strings.LastIndexAny(pattern, "#0")
+	if sym < 0 || first < 0 {
+		return true, false
+	}
+	// Characters between the symbol and the number, including no-break spaces
+	var between string
+	if sym < first {
+		prefix = true
+		between = pattern[sym+len("¤") : first]
+	} else {
+		between = pattern[last+1 : sym]
+	}
+	return prefix, between != "" && strings.TrimSpace(between) == ""
+}
+
+func generateGoCode(filename string, data any) ([]byte, error) {
 	// Create a new template object from the template file
 	fmap := template.FuncMap{
 		"lower": strings.ToLower,
@@ -113,7 +459,7 @@ func generateGoCode(filename string, currs []currency) ([]byte, error) {
 
 	// Execute the template
 	var output bytes.Buffer
-	err = tmpl.Execute(&output, currs)
+	err = tmpl.Execute(&output, data)
 	if err != nil {
 		return nil, err
 	}
@@ -155,11 +501,16 @@ type CurrencyTable struct {
 }
 
 type CurrencyEntry struct {
-	CountryName    string `xml:"CtryNm"`
-	CurrencyName   string `xml:"CcyNm"`
-	CurrencyCode   string `xml:"Ccy"`
-	CurrencyNumber string `xml:"CcyNbr"`
-	MinorUnits     string `xml:"CcyMnrUnts"`
+	CountryName    string       `xml:"CtryNm"`
+	CurrencyName   CurrencyName `xml:"CcyNm"`
+	CurrencyCode   string       `xml:"Ccy"`
+	CurrencyNumber string       `xml:"CcyNbr"`
+	MinorUnits     string       `xml:"CcyMnrUnts"`
+}
+
+type CurrencyName struct {
+	Name   string `xml:",chardata"`
+	IsFund bool   `xml:"IsFund,attr"`
 }
 
 // UpdateCurrencyData downloads the latest ISO 4217 currency list and updates currency_data.csv
@@ -209,10 +560,11 @@ func UpdateCurrencyData() error {
 
 		// Use currency code as key to deduplicate
 		currencyMap[entry.CurrencyCode] = currency{
-			Name:  entry.CurrencyName,
+			Name:  entry.CurrencyName.Name,
 			Code:  entry.CurrencyCode,
 			Num:   entry.CurrencyNumber,
 			Scale: scale,
+			Fund:  entry.CurrencyName.IsFund,
 		}
 	}
 
@@ -254,13 +606,13 @@ func UpdateCurrencyData() error {
 	defer writer.Flush()
 
 	// Write header
-	if err := writer.Write([]string{"Name", "Code", "Num", "Scale"}); err != nil {
+	if err := writer.Write([]string{"Name", "Code", "Num", "Scale", "Fund"}); err != nil {
 		return fmt.Errorf("failed to write CSV header: %v", err)
 	}
 
 	// Write currency data
 	for _, curr := range currencies {
-		record := []string{curr.Name, curr.Code, curr.Num, curr.Scale}
+		record := []string{curr.Name, curr.Code, curr.Num, curr.Scale, strconv.FormatBool(curr.Fund)}
 		if err := writer.Write(record); err != nil {
 			return fmt.Errorf("failed to write CSV record: %v", err)
 		}
@@ -268,3 +620,456 @@ func UpdateCurrencyData() error {
 
 	return nil
 }
+
+// cldrLocales lists the locales for which the CLDR data is downloaded.
+// Locales are identified either by language or by language and region.
+var cldrLocales = []string{
+	"en", "en-AU", "en-CA", "en-NZ",
+	"de", "de-AT", "de-CH",
+	"es", "es-MX",
+	"fr", "fr-CA", "fr-CH",
+	"it", "ja", "ko", "nl", "pl",
+	"pt", "pt-PT",
+	"ru", "sv", "zh",
+}
+
+// JSON structures for parsing CLDR number and currency data
+type CLDRNumbers struct {
+	Main map[string]struct {
+		Numbers struct {
+			Symbols struct {
+				Decimal string `json:"decimal"`
+				Group   string `json:"group"`
+			} `json:"symbols-numberSystem-latn"`
+			CurrencyFormats struct {
+				Standard string `json:"standard"`
+			} `json:"currencyFormats-numberSystem-latn"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+type CLDRCurrencies struct {
+	Main map[string]struct {
+		Numbers struct {
+			Currencies map[string]struct {
+				DisplayName string `json:"displayName"`
+				Symbol      string `json:"symbol"`
+				Narrow      string `json:"symbol-alt-narrow"`
+			} `json:"currencies"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+// downloadCLDR downloads and parses a CLDR JSON file for the locale.
+func downloadCLDR(tag, file string, v any) error {
+	url := fmt.Sprintf("https://raw.githubusercontent.com/unicode-org/cldr-json/main/cldr-json/cldr-numbers-full/main/%s/%s", tag, file)
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to download JSON: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download JSON: status %d", resp.StatusCode)
+	}
+
+	// Read and parse the JSON data
+	jsonData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read JSON data: %v", err)
+	}
+	err = json.Unmarshal(jsonData, v)
+	if err != nil {
+		return fmt.Errorf("failed to parse JSON: %v", err)
+	}
+	return nil
+}
+
+// UpdateLocaleData downloads the latest CLDR number and currency data and
+// updates locale_data.csv, symbol_data.csv, and name_data.csv.
+// Narrow symbols are only kept for the English locale.
+// Display names are kept for other locales if they differ from the ISO 4217
+// name, or for regional locales, from the name in the language of the locale.
+func UpdateLocaleData(currs []currency) error {
+	var locRecs, symRecs, nameRecs [][]string
+	enSymbols := map[string]string{}
+	langNames := map[string]map[string]string{}
+	for _, tag := range cldrLocales {
+		// Separators and currency pattern
+		var nums CLDRNumbers
+		if err := downloadCLDR(tag, "numbers.json", &nums); err != nil {
+			return fmt.Errorf("locale %v: %v", tag, err)
+		}
+		n := nums.Main[tag].Numbers
+		locRecs = append(locRecs, []string{tag, n.Symbols.Decimal, n.Symbols.Group, n.CurrencyFormats.Standard})
+
+		// Currency symbols
+		var cldr CLDRCurrencies
+		if err := downloadCLDR(tag, "currencies.json", &cldr); err != nil {
+			return fmt.Errorf("locale %v: %v", tag, err)
+		}
+		for _, curr := range currs {
+			sym := cldr.Main[tag].Numbers.Currencies[curr.Code].Symbol
+			if sym == "" {
+				sym = curr.Code
+			}
+			narrow := ""
+			if tag == "en" {
+				// Keep English symbols and narrow symbols that differ from the code
+				narrow = cldr.Main[tag].Numbers.Currencies[curr.Code].Narrow
+				if narrow == sym {
+					narrow = ""
+				}
+				if sym == curr.Code && narrow == "" {
+					continue
+				}
+				if sym != curr.Code {
+					enSymbols[curr.Code] = sym
+				}
+			} else {
+				// Keep symbols that differ from the English ones
+				en, ok := enSymbols[curr.Code]
+				if !ok {
+					en = curr.Code
+				}
+				if sym == en {
+					continue
+				}
+			}
+			symRecs = append(symRecs, []string{tag, curr.Code, sym, narrow})
+		}
+
+		// Currency display names
+		if !*names || tag == "en" {
+			continue
+		}
+		lang, _, regional := strings.Cut(tag, "-")
+		if !regional {
+			langNames[lang] = map[string]string{}
+		}
+		for _, curr := range currs {
+			name := cldr.Main[tag].Numbers.Currencies[curr.Code].DisplayName
+			base := curr.Name
+			if regional {
+				if n, ok := langNames[lang][curr.Code]; ok {
+					base = n
+				}
+			} else if name != "" {
+				langNames[lang][curr.Code] = name
+			}
+			if name == "" || name == base {
+				continue
+			}
+			nameRecs = append(nameRecs, []string{tag, curr.Code, name})
+		}
+	}
+
+	// Write to CSV files
+	if err := writeCsvFile(filepath.Join("scripts", "currency", "locale_data.csv"), []string{"Locale", "Decimal", "Group", "Pattern"}, locRecs); err != nil {
+		return err
+	}
+	if err := writeCsvFile(filepath.Join("scripts", "currency", "symbol_data.csv"), []string{"Locale", "Code", "Symbol", "Narrow"}, symRecs); err != nil {
+		return err
+	}
+	if err := writeCsvFile(filepath.Join("scripts", "currency", "name_data.csv"), []string{"Locale", "Code", "Name"}, nameRecs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func writeCsvFile(filename string, header []string, recs [][]string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %v", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %v", err)
+	}
+	if err := writer.WriteAll(recs); err != nil {
+		return fmt.Errorf("failed to write CSV records: %v", err)
+	}
+	return nil
+}
+
+// XML structures for parsing ISO 4217 historic currency data
+type ISO4217Historic struct {
+	CurrencyTable HistoricCurrencyTable `xml:"HstrcCcyTbl"`
+}
+
+type HistoricCurrencyTable struct {
+	Entries []HistoricCurrencyEntry `xml:"HstrcCcyNtry"`
+}
+
+type HistoricCurrencyEntry struct {
+	CountryName    string `xml:"CtryNm"`
+	CurrencyName   string `xml:"CcyNm"`
+	CurrencyCode   string `xml:"Ccy"`
+	CurrencyNumber string `xml:"CcyNbr"`
+	WithdrawalDate string `xml:"WthdrwlDt"`
+}
+
+// UpdateHistoricalData downloads the latest ISO 4217 list of historic
+// currencies and updates historical_data.csv.
+// Currencies that are still listed as current are skipped.
+// Unlike list one, list three does not specify minor units, so they are
+// taken from the CLDR currency fractions.
+func UpdateHistoricalData(currs []currency) error {
+	// Download the XML file
+	resp, err := http.Get("https://www.six-group.com/dam/download/financial-information/data-center/iso-currrency/lists/list-three.xml")
+	if err != nil {
+		return fmt.Errorf("failed to download XML: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download XML: status %d", resp.StatusCode)
+	}
+
+	// Read the XML data
+	xmlData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read XML data: %v", err)
+	}
+
+	// Parse the XML
+	var iso4217 ISO4217Historic
+	err = xml.Unmarshal(xmlData, &iso4217)
+	if err != nil {
+		return fmt.Errorf("failed to parse XML: %v", err)
+	}
+
+	// Minor units
+	cldr, err := downloadCLDRCurrencyData()
+	if err != nil {
+		return err
+	}
+	digits := make(map[string]string)
+	for code, f := range cldr.Supplemental.CurrencyData.Fractions {
+		digits[code] = f.Digits
+	}
+
+	recs, err := convertHistoricEntries(iso4217.CurrencyTable.Entries, currs, digits)
+	if err != nil {
+		return err
+	}
+
+	// Write to CSV file
+	return writeCsvFile(filepath.Join("scripts", "currency", "historical_data.csv"), []string{"Name", "Code", "Num", "Scale"}, recs)
+}
+
+// convertHistoricEntries converts the entries of ISO 4217 list three to
+// records of historical_data.csv sorted by code.
+// Entries of current currencies are skipped.
+// If a code is listed several times, the entry with the latest withdrawal
+// date is used.
+// Minor units are looked up in digits, which maps codes to the CLDR
+// fraction digits, falling back to the CLDR "DEFAULT" entry.
+func convertHistoricEntries(entries []HistoricCurrencyEntry, currs []currency, digits map[string]string) ([][]string, error) {
+	// Current currencies
+	current := make(map[string]bool)
+	for _, curr := range currs {
+		current[curr.Code] = true
+	}
+
+	// Deduplicate by code, keeping the latest withdrawal
+	latest := make(map[string]HistoricCurrencyEntry)
+	for _, entry := range entries {
+		// Skip entries without currency codes and current currencies
+		if entry.CurrencyCode == "" || current[entry.CurrencyCode] {
+			continue
+		}
+		prev, ok := latest[entry.CurrencyCode]
+		if !ok || withdrawalKey(entry.WithdrawalDate) > withdrawalKey(prev.WithdrawalDate) {
+			latest[entry.CurrencyCode] = entry
+		}
+	}
+
+	// Convert to records with scales
+	var recs [][]string
+	for code, entry := range latest {
+		scale, ok := digits[code]
+		if !ok {
+			scale, ok = digits["DEFAULT"]
+		}
+		if !ok || scale == "" {
+			return nil, fmt.Errorf("currency %v: unknown minor units", code)
+		}
+		recs = append(recs, []string{entry.CurrencyName, code, entry.CurrencyNumber, scale})
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		return recs[i][1] < recs[j][1]
+	})
+	return recs, nil
+}
+
+// withdrawalKey returns the last date in a withdrawal period of ISO 4217
+// list three, such as "2002-03" or "1989 to 1990", in a form that can be
+// compared as a string.
+func withdrawalKey(period string) string {
+	fields := strings.FieldsFunc(period, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '-'
+	})
+	for i := len(fields) - 1; i >= 0; i-- {
+		if f := strings.Trim(fields[i], "-"); len(f) >= 4 {
+			return f
+		}
+	}
+	return ""
+}
+
+// JSON structures for parsing CLDR currency fractions
+type CLDRCurrencyData struct {
+	Supplemental struct {
+		CurrencyData struct {
+			Fractions map[string]struct {
+				Digits       string `json:"_digits"`
+				Rounding     string `json:"_rounding"`
+				CashDigits   string `json:"_cashDigits"`
+				CashRounding string `json:"_cashRounding"`
+			} `json:"fractions"`
+			Region map[string][]map[string]struct {
+				From   string `json:"_from"`
+				To     string `json:"_to"`
+				Tender string `json:"_tender"`
+			} `json:"region"`
+		} `json:"currencyData"`
+	} `json:"supplemental"`
+}
+
+// downloadCLDRCurrencyData downloads and parses the CLDR supplemental
+// currency data.
+func downloadCLDRCurrencyData() (CLDRCurrencyData, error) {
+	var cldr CLDRCurrencyData
+	resp, err := http.Get("https://raw.githubusercontent.com/unicode-org/cldr-json/main/cldr-json/cldr-core/supplemental/currencyData.json")
+	if err != nil {
+		return cldr, fmt.Errorf("failed to download JSON: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return cldr, fmt.Errorf("failed to download JSON: status %d", resp.StatusCode)
+	}
+
+	// Read and parse the JSON data
+	jsonData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cldr, fmt.Errorf("failed to read JSON data: %v", err)
+	}
+	err = json.Unmarshal(jsonData, &cldr)
+	if err != nil {
+		return cldr, fmt.Errorf("failed to parse JSON: %v", err)
+	}
+	return cldr, nil
+}
+
+// UpdateCashData downloads the latest CLDR currency fractions and updates
+// cash_data.csv with the smallest units that can be paid in cash.
+// Only currencies whose cash unit is a multiple of their minor unit
+// are included.
+func UpdateCashData(currs []currency) error {
+	cldr, err := downloadCLDRCurrencyData()
+	if err != nil {
+		return err
+	}
+
+	var recs [][]string
+	for _, curr := range currs {
+		f, ok := cldr.Supplemental.CurrencyData.Fractions[curr.Code]
+		if !ok {
+			continue
+		}
+		// Cash digits and rounding default to the regular ones
+		digits, rounding := f.CashDigits, f.CashRounding
+		if digits == "" {
+			digits = f.Digits
+		}
+		if rounding == "" {
+			rounding = f.Rounding
+		}
+		if rounding == "" || rounding == "0" {
+			rounding = "1"
+		}
+		d, err := strconv.Atoi(digits)
+		if err != nil {
+			return fmt.Errorf("currency %v: %v", curr.Code, err)
+		}
+		s, err := strconv.Atoi(curr.Scale)
+		if err != nil {
+			return fmt.Errorf("currency %v: %v", curr.Code, err)
+		}
+		if d > s || d == s && rounding == "1" {
+			continue
+		}
+		// Unit is rounding * 10^-digits
+		unit := rounding
+		if d > 0 {
+			unit = strings.Repeat("0", max(d+1-len(unit), 0)) + unit
+			unit = unit[:len(unit)-d] + "." + unit[len(unit)-d:]
+		}
+		recs = append(recs, []string{curr.Code, unit})
+	}
+
+	// Write to CSV file
+	return writeCsvFile(filepath.Join("scripts", "currency", "cash_data.csv"), []string{"Code", "Unit"}, recs)
+}
+
+// UpdateCountryData downloads the latest CLDR currency data and updates
+// country_data.csv with the countries where each currency is in use.
+// Funds are associated with the countries of their underlying currencies.
+// Only officially assigned ISO 3166 country codes are included.
+func UpdateCountryData(currs []currency) error {
+	cldr, err := downloadCLDRCurrencyData()
+	if err != nil {
+		return err
+	}
+
+	// Current currencies of each country
+	countries := make(map[string][]string)
+	for region, hist := range cldr.Supplemental.CurrencyData.Region {
+		if !isCountry(region) {
+			continue
+		}
+		for _, entry := range hist {
+			for code, period := range entry {
+				if period.To != "" {
+					continue
+				}
+				countries[code] = append(countries[code], region)
+			}
+		}
+	}
+
+	var recs [][]string
+	for _, curr := range currs {
+		regions := countries[curr.Code]
+		if len(regions) == 0 {
+			continue
+		}
+		sort.Strings(regions)
+		recs = append(recs, []string{curr.Code, strings.Join(regions, " ")})
+	}
+
+	// Write to CSV file
+	return writeCsvFile(filepath.Join("scripts", "currency", "country_data.csv"), []string{"Code", "Countries"}, recs)
+}
+
+// isCountry reports whether the CLDR region is an officially assigned
+// ISO 3166 country code, rather than a continent, a user-assigned code,
+// or an exceptionally reserved code.
+func isCountry(region string) bool {
+	if len(region) != 2 || region[0] < 'A' || region[0] > 'Z' || region[1] < 'A' || region[1] > 'Z' {
+		return false
+	}
+	switch {
+	case region == "AA", region == "ZZ", region[0] == 'X', region[0] == 'Q' && region[1] >= 'M':
+		return false // user-assigned
+	}
+	switch region {
+	case "AC", "CP", "CQ", "DG", "EA", "EU", "EZ", "FX", "IC", "SU", "TA", "UK", "UN":
+		return false // exceptionally reserved
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// chdirRoot changes the working directory to the root of the module,
+// where the generator expects to be run by go generate.
+func chdirRoot(t *testing.T) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("os.Getwd() failed: %v", err)
+	}
+	if err := os.Chdir(filepath.Join("..", "..")); err != nil {
+		t.Fatalf("os.Chdir() failed: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+func TestGeneratedDataUpToDate(t *testing.T) {
+	chdirRoot(t)
+	if err := verifyChecksums(); err != nil {
+		t.Fatalf("verifyChecksums() failed: %v", err)
+	}
+	files, err := generateFiles()
+	if err != nil {
+		t.Fatalf("generateFiles() failed: %v", err)
+	}
+	stale, err := staleFiles(files)
+	if err != nil {
+		t.Fatalf("staleFiles() failed: %v", err)
+	}
+	if len(stale) > 0 {
+		t.Errorf("generated code is stale, run go generate to update: %v", stale)
+	}
+}
+
+func TestStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	current := filepath.Join(dir, "current.go")
+	outdated := filepath.Join(dir, "outdated.go")
+	missing := filepath.Join(dir, "missing.go")
+	if err := os.WriteFile(current, []byte("package money\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(outdated, []byte("package money\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	files := []generatedFile{
+		{name: current, code: []byte("package money\n")},
+		{name: outdated, code: []byte("package money\n\nvar x int\n")},
+		{name: missing, code: []byte("package money\n")},
+	}
+	got, err := staleFiles(files)
+	if err != nil {
+		t.Fatalf("staleFiles() failed: %v", err)
+	}
+	if want := []string{outdated, missing}; !slices.Equal(got, want) {
+		t.Errorf("staleFiles() = %v, want %v", got, want)
+	}
+}
+
+func TestConvertDataToNames(t *testing.T) {
+	data := [][]string{
+		{"de", "USD", "US-Dollar"},
+		{"de", "JPY", "Japanischer Yen"},
+		{"fr", "USD", "dollar des États-Unis"},
+		{"fr-CA", "USD", "dollar américain"},
+	}
+	got := convertDataToNames(data)
+	want := []displayNames{
+		{Tag: "de", Names: []displayName{{"USD", "US-Dollar"}, {"JPY", "Japanischer Yen"}}},
+		{Tag: "fr", Names: []displayName{{"USD", "dollar des États-Unis"}}},
+		{Tag: "fr-CA", Names: []displayName{{"USD", "dollar américain"}}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("convertDataToNames() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Tag != want[i].Tag || !slices.Equal(got[i].Names, want[i].Names) {
+			t.Errorf("convertDataToNames()[%v] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got := convertDataToNames(nil); len(got) != 0 {
+		t.Errorf("convertDataToNames(nil) = %v, want empty", got)
+	}
+}
+
+func TestConvertHistoricEntries(t *testing.T) {
+	currs := []currency{{Name: "Euro", Code: "EUR", Num: "978", Scale: "2"}}
+	digits := map[string]string{"DEFAULT": "2", "ESP": "0"}
+
+	t.Run("success", func(t *testing.T) {
+		entries := []HistoricCurrencyEntry{
+			{CurrencyName: "Spanish Peseta", CurrencyCode: "ESP", CurrencyNumber: "724", WithdrawalDate: "2002-03"},
+			{CurrencyName: "Spanish Peseta (account A)", CurrencyCode: "ESA", CurrencyNumber: "996", WithdrawalDate: "1978 to 1981"},
+			{CurrencyName: "Deutsche Mark", CurrencyCode: "DEM", CurrencyNumber: "276", WithdrawalDate: "2002-03"},
+			{CurrencyName: "Old Name", CurrencyCode: "ZWD", CurrencyNumber: "716", WithdrawalDate: "2008-08"},
+			{CurrencyName: "Zimbabwe Dollar", CurrencyCode: "ZWD", CurrencyNumber: "716", WithdrawalDate: "2006-08"},
+			{CurrencyName: "Euro", CurrencyCode: "EUR", CurrencyNumber: "978", WithdrawalDate: "1999-01"},
+			{CurrencyName: "Without code", WithdrawalDate: "1990"},
+		}
+		got, err := convertHistoricEntries(entries, currs, digits)
+		if err != nil {
+			t.Fatalf("convertHistoricEntries() failed: %v", err)
+		}
+		want := [][]string{
+			{"Deutsche Mark", "DEM", "276", "2"},
+			{"Spanish Peseta (account A)", "ESA", "996", "2"},
+			{"Spanish Peseta", "ESP", "724", "0"},
+			{"Old Name", "ZWD", "716", "2"},
+		}
+		if !slices.EqualFunc(got, want, slices.Equal) {
+			t.Errorf("convertHistoricEntries() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		entries := []HistoricCurrencyEntry{
+			{CurrencyName: "Deutsche Mark", CurrencyCode: "DEM", CurrencyNumber: "276", WithdrawalDate: "2002-03"},
+		}
+		_, err := convertHistoricEntries(entries, currs, map[string]string{"ESP": "0"})
+		if err == nil {
+			t.Errorf("convertHistoricEntries() did not fail")
+		}
+	})
+}
+
+func TestWithdrawalKey(t *testing.T) {
+	tests := []struct {
+		period, want string
+	}{
+		{"2002-03", "2002-03"},
+		{"1989 to 1990", "1990"},
+		{"1989-12 to 1990-01", "1990-01"},
+		{"", ""},
+		{"unknown", ""},
+	}
+	for _, tt := range tests {
+		if got := withdrawalKey(tt.period); got != tt.want {
+			t.Errorf("withdrawalKey(%q) = %q, want %q", tt.period, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,497 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/govalues/decimal"
+)
+
+var errNoAmounts = errors.New("no amounts")
+
+// Summer accumulates amounts one at a time and keeps their sum, count,
+// minimum and maximum, so that large collections, such as millions of
+// transactions read from a database, can be aggregated without holding
+// them in memory.
+// All amounts must be denominated in the same currency.
+// The zero value is an empty accumulator ready to use.
+// See also functions [Sum], [Mean], [Min], and [Max].
+type Summer struct {
+	sum, min, max Amount
+	count         int
+}
+
+// Add adds the amount to the accumulator.
+// If an error is returned, the accumulator is left unchanged, so the amount
+// can be skipped or handled separately.
+//
+// Add returns an error if:
+//   - the amount is denominated in a different currency than the amounts
+//     added before;
+//   - the integer part of the sum has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (s *Summer) Add(a Amount) error {
+	if err := s.add(a); err != nil {
+		return fmt.Errorf("computing sum: %w", err)
+	}
+	return nil
+}
+
+func (s *Summer) add(a Amount) error {
+	if s.count == 0 {
+		s.sum, s.min, s.max, s.count = a, a, a, 1
+		return nil
+	}
+	sum, err := s.sum.Add(a)
+	if err != nil {
+		return err
+	}
+	s.min, _ = s.min.Min(a) // currencies are already checked by Add
+	s.max, _ = s.max.Max(a)
+	s.sum = sum
+	s.count++
+	return nil
+}
+
+// Count returns the number of amounts added to the accumulator.
+func (s *Summer) Count() int {
+	return s.count
+}
+
+// Sum returns the sum of the amounts added to the accumulator.
+//
+// Sum returns an error if no amounts have been added.
+func (s *Summer) Sum() (Amount, error) {
+	if s.count == 0 {
+		return Amount{}, fmt.Errorf("computing sum: %w", errNoAmounts)
+	}
+	return s.sum, nil
+}
+
+// Mean returns the arithmetic mean of the amounts added to the accumulator,
+// rounded to the scale of the currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+//
+// Mean returns an error if no amounts have been added.
+func (s *Summer) Mean(mode ...RoundingMode) (Amount, error) {
+	if s.count == 0 {
+		return Amount{}, fmt.Errorf("computing mean: %w", errNoAmounts)
+	}
+	n, err := decimal.New(int64(s.count), 0)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing mean: %w", err)
+	}
+	m, err := s.sum.QuoRound(n, mode...)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing mean: %w", err)
+	}
+	return m, nil
+}
+
+// Min returns the smallest of the amounts added to the accumulator.
+// See also method [Amount.Min].
+//
+// Min returns an error if no amounts have been added.
+func (s *Summer) Min() (Amount, error) {
+	if s.count == 0 {
+		return Amount{}, fmt.Errorf("computing minimum: %w", errNoAmounts)
+	}
+	return s.min, nil
+}
+
+// Max returns the largest of the amounts added to the accumulator.
+// See also method [Amount.Max].
+//
+// Max returns an error if no amounts have been added.
+func (s *Summer) Max() (Amount, error) {
+	if s.count == 0 {
+		return Amount{}, fmt.Errorf("computing maximum: %w", errNoAmounts)
+	}
+	return s.max, nil
+}
+
+// Reset empties the accumulator.
+func (s *Summer) Reset() {
+	*s = Summer{}
+}
+
+// RunningBalance is a running total that can be updated concurrently by
+// multiple goroutines, such as the balance of an account in a high-throughput
+// payment service.
+// The currency of the balance is pinned by [NewRunningBalance] or by the first
+// amount added or subtracted, and amounts in other currencies are rejected.
+// The zero value is an empty balance ready to use.
+// A RunningBalance must not be copied after first use.
+// See also type [Summer] for accumulating amounts in a single goroutine.
+type RunningBalance struct {
+	mu     sync.Mutex
+	bal    Amount
+	pinned bool
+}
+
+// NewRunningBalance returns a zero balance pinned to the given currency.
+func NewRunningBalance(curr Currency) *RunningBalance {
+	return &RunningBalance{bal: newAmountUnsafe(curr, decimal.Zero.Pad(curr.Scale())), pinned: true}
+}
+
+// Add adds the amount to the balance.
+// If an error is returned, the balance is left unchanged.
+//
+// Add returns an error if:
+//   - the amount is denominated in a different currency than the balance;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (b *RunningBalance) Add(a Amount) error {
+	return b.update(a, Amount.Add)
+}
+
+// Sub subtracts the amount from the balance.
+// The balance can become negative.
+// If an error is returned, the balance is left unchanged.
+//
+// Sub returns an error if:
+//   - the amount is denominated in a different currency than the balance;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (b *RunningBalance) Sub(a Amount) error {
+	return b.update(a, Amount.Sub)
+}
+
+func (b *RunningBalance) update(a Amount, op func(x, y Amount) (Amount, error)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.pinned {
+		b.bal = newAmountUnsafe(a.Curr(), decimal.Zero.Pad(a.Curr().Scale()))
+		b.pinned = true
+	}
+	c, err := op(b.bal, a)
+	if err != nil {
+		return err
+	}
+	b.bal = c
+	return nil
+}
+
+// Snapshot returns the current balance.
+// If no currency has been pinned yet, it returns "XXX 0".
+func (b *RunningBalance) Snapshot() Amount {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bal
+}
+
+// Curr returns the pinned currency of the balance, and false if no currency
+// has been pinned yet.
+func (b *RunningBalance) Curr() (Currency, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bal.Curr(), b.pinned
+}
+
+// summarize adds all amounts of the sequence to a new accumulator,
+// stopping at the first error.
+func summarize(amounts iter.Seq[Amount]) (*Summer, error) {
+	var s Summer
+	for a := range amounts {
+		if err := s.add(a); err != nil {
+			return nil, err
+		}
+	}
+	return &s, nil
+}
+
+// Sum returns the sum of the amounts in the sequence.
+// To sum a slice, use [slices.Values].
+// See also type [Summer].
+//
+// Sum returns an error if:
+//   - the sequence is empty;
+//   - amounts are denominated in different currencies;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [slices.Values]: https://pkg.go.dev/slices#Values
+func Sum(amounts iter.Seq[Amount]) (Amount, error) {
+	s, err := summarize(amounts)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing sum: %w", err)
+	}
+	return s.Sum()
+}
+
+// Mean returns the arithmetic mean of the amounts in the sequence, rounded
+// to the scale of the currency using the specified rounding mode.
+// If the rounding mode is omitted, [DefaultRoundingMode] is used.
+// To average a slice, use [slices.Values].
+// See also type [Summer].
+//
+// Mean returns an error if:
+//   - the sequence is empty;
+//   - amounts are denominated in different currencies;
+//   - the integer part of the sum has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [slices.Values]: https://pkg.go.dev/slices#Values
+func Mean(amounts iter.Seq[Amount], mode ...RoundingMode) (Amount, error) {
+	s, err := summarize(amounts)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing mean: %w", err)
+	}
+	return s.Mean(mode...)
+}
+
+// Min returns the smallest amount in the sequence.
+// See also method [Amount.Min].
+//
+// Min returns an error if:
+//   - the sequence is empty;
+//   - amounts are denominated in different currencies.
+func Min(amounts iter.Seq[Amount]) (Amount, error) {
+	c, err := extremum(amounts, Amount.Min)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing minimum: %w", err)
+	}
+	return c, nil
+}
+
+// Max returns the largest amount in the sequence.
+// See also method [Amount.Max].
+//
+// Max returns an error if:
+//   - the sequence is empty;
+//   - amounts are denominated in different currencies.
+func Max(amounts iter.Seq[Amount]) (Amount, error) {
+	c, err := extremum(amounts, Amount.Max)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing maximum: %w", err)
+	}
+	return c, nil
+}
+
+func extremum(amounts iter.Seq[Amount], pick func(a, b Amount) (Amount, error)) (Amount, error) {
+	var c Amount
+	var ok bool
+	for a := range amounts {
+		if !ok {
+			c, ok = a, true
+			continue
+		}
+		var err error
+		c, err = pick(c, a)
+		if err != nil {
+			return Amount{}, err
+		}
+	}
+	if !ok {
+		return Amount{}, errNoAmounts
+	}
+	return c, nil
+}
+
+// Compare compares amounts and returns:
+//
+//	-1 if a < b
+//	 0 if a = b
+//	+1 if a > b
+//
+// Amounts denominated in different currencies are ordered by the codes of
+// their currencies, so Compare defines a total order on all amounts and can be
+// passed to [slices.SortFunc], [slices.BinarySearchFunc], and similar
+// functions.
+// To reject amounts in different currencies instead, use [SortAscending],
+// [SortDescending], or method [Amount.Cmp].
+//
+// [slices.SortFunc]: https://pkg.go.dev/slices#SortFunc
+// [slices.BinarySearchFunc]: https://pkg.go.dev/slices#BinarySearchFunc
+func Compare(a, b Amount) int {
+	if !a.SameCurr(b) {
+		return strings.Compare(a.Curr().Code(), b.Curr().Code())
+	}
+	d, e := a.Decimal(), b.Decimal()
+	return d.Cmp(e)
+}
+
+// SortAscending sorts the amounts in place from the smallest to the largest.
+// The sort is stable: equal amounts, such as "USD 1.0" and "USD 1.00", keep
+// their original order.
+// See also function [Compare].
+//
+// SortAscending returns an error if amounts are denominated in different
+// currencies, in which case the slice is left unchanged.
+func SortAscending(amounts []Amount) error {
+	if err := sameCurr(amounts); err != nil {
+		return fmt.Errorf("sorting amounts: %w", err)
+	}
+	slices.SortStableFunc(amounts, Compare)
+	return nil
+}
+
+// SortDescending sorts the amounts in place from the largest to the smallest.
+// The sort is stable: equal amounts, such as "USD 1.0" and "USD 1.00", keep
+// their original order.
+// See also function [Compare].
+//
+// SortDescending returns an error if amounts are denominated in different
+// currencies, in which case the slice is left unchanged.
+func SortDescending(amounts []Amount) error {
+	if err := sameCurr(amounts); err != nil {
+		return fmt.Errorf("sorting amounts: %w", err)
+	}
+	slices.SortStableFunc(amounts, func(a, b Amount) int { return Compare(b, a) })
+	return nil
+}
+
+// sameCurr checks that all amounts are denominated in the same currency.
+func sameCurr(amounts []Amount) error {
+	for i := 1; i < len(amounts); i++ {
+		if !amounts[i].SameCurr(amounts[0]) {
+			return fmt.Errorf("[%v] and [%v]: %w", amounts[0], amounts[i], ErrCurrencyMismatch)
+		}
+	}
+	return nil
+}
+
+// Total returns the sum of the amounts in the slice.
+// Unlike [Sum], Total checks the currencies of all amounts once before
+// summing them, which makes it faster for large slices.
+//
+// Total returns an error if:
+//   - the slice is empty;
+//   - amounts are denominated in different currencies;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func Total(amounts []Amount) (Amount, error) {
+	c, err := total(amounts)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing total: %w", err)
+	}
+	return c, nil
+}
+
+func total(amounts []Amount) (Amount, error) {
+	if len(amounts) == 0 {
+		return Amount{}, errNoAmounts
+	}
+	if err := sameCurr(amounts); err != nil {
+		return Amount{}, err
+	}
+	m, d := amounts[0].Curr(), amounts[0].Decimal()
+	for _, a := range amounts[1:] {
+		var err error
+		d, err = d.AddExact(a.Decimal(), m.Scale())
+		if err != nil {
+			return Amount{}, decimalErr(err)
+		}
+	}
+	return newAmountSafe(m, d)
+}
+
+// MinOf returns the smallest amount in the slice.
+// Equal amounts with different scales, such as "USD 1.0" and "USD 1.00",
+// are ordered as in method [Amount.Min].
+// Unlike [Min], MinOf checks the currencies of all amounts once before
+// comparing them, which makes it faster for large slices.
+//
+// MinOf returns an error if:
+//   - the slice is empty;
+//   - amounts are denominated in different currencies.
+func MinOf(amounts []Amount) (Amount, error) {
+	c, err := extremumOf(amounts, -1)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing minimum: %w", err)
+	}
+	return c, nil
+}
+
+// MaxOf returns the largest amount in the slice.
+// Equal amounts with different scales, such as "USD 1.0" and "USD 1.00",
+// are ordered as in method [Amount.Max].
+// Unlike [Max], MaxOf checks the currencies of all amounts once before
+// comparing them, which makes it faster for large slices.
+//
+// MaxOf returns an error if:
+//   - the slice is empty;
+//   - amounts are denominated in different currencies.
+func MaxOf(amounts []Amount) (Amount, error) {
+	c, err := extremumOf(amounts, 1)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing maximum: %w", err)
+	}
+	return c, nil
+}
+
+// extremumOf returns the smallest amount of the slice if sign is -1,
+// and the largest amount if sign is +1.
+func extremumOf(amounts []Amount, sign int) (Amount, error) {
+	if len(amounts) == 0 {
+		return Amount{}, errNoAmounts
+	}
+	if err := sameCurr(amounts); err != nil {
+		return Amount{}, err
+	}
+	i, d := 0, amounts[0].Decimal()
+	for j := 1; j < len(amounts); j++ {
+		e := amounts[j].Decimal()
+		if e.CmpTotal(d) == sign {
+			i, d = j, e
+		}
+	}
+	return amounts[i], nil
+}
+
+// ClampSlice replaces every amount in the slice that is less than min with
+// min, and every amount that is greater than max with max, in place.
+// Unlike calling [Amount.Clamp] for every amount, ClampSlice checks
+// the currencies of all amounts once before comparing them, which makes it
+// faster for large slices.
+//
+// ClampSlice returns an error if:
+//   - amounts, min, or max are denominated in different currencies;
+//   - min is greater than max numerically.
+//
+// If an error is returned, the slice is left unchanged.
+//
+//nolint:revive
+func ClampSlice(amounts []Amount, min, max Amount) error {
+	if err := clampSlice(amounts, min, max); err != nil {
+		return fmt.Errorf("clamping amounts: %w", err)
+	}
+	return nil
+}
+
+//nolint:revive
+func clampSlice(amounts []Amount, min, max Amount) error {
+	if !min.SameCurr(max) {
+		return fmt.Errorf("[%v] and [%v]: %w", min, max, ErrCurrencyMismatch)
+	}
+	if len(amounts) > 0 && !amounts[0].SameCurr(min) {
+		return fmt.Errorf("[%v] and [%v]: %w", amounts[0], min, ErrCurrencyMismatch)
+	}
+	if err := sameCurr(amounts); err != nil {
+		return err
+	}
+	lo, hi := min.Decimal(), max.Decimal()
+	switch lo.CmpTotal(hi) {
+	case 1:
+		if lo.Cmp(hi) > 0 {
+			return fmt.Errorf("[%v] and [%v]: invalid range", min, max)
+		}
+		// Numerically min and max are equal but have different scales.
+		// Swapping min and max to ensure total ordering, as in Amount.Clamp.
+		min, max, lo, hi = max, min, hi, lo
+	}
+	for i, a := range amounts {
+		d := a.Decimal()
+		switch {
+		case d.CmpTotal(lo) < 0:
+			amounts[i] = min
+		case d.CmpTotal(hi) > 0:
+			amounts[i] = max
+		}
+	}
+	return nil
+}
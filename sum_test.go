@@ -0,0 +1,613 @@
+package money
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSummer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts                             []string
+			wantSum, wantMean, wantMin, wantMax string
+		}{
+			{[]string{"USD 1.00"}, "USD 1.00", "USD 1.00", "USD 1.00", "USD 1.00"},
+			{[]string{"USD 1.00", "USD 2.00"}, "USD 3.00", "USD 1.50", "USD 1.00", "USD 2.00"},
+			{[]string{"USD 1.00", "USD 1.00", "USD 2.00"}, "USD 4.00", "USD 1.33", "USD 1.00", "USD 2.00"},
+			{[]string{"USD 0.01", "USD 0.02"}, "USD 0.03", "USD 0.02", "USD 0.01", "USD 0.02"},
+			{[]string{"USD -5.00", "USD 3.00", "USD 0.005"}, "USD -1.995", "USD -0.66", "USD -5.00", "USD 3.00"},
+			{[]string{"JPY 1", "JPY 2", "JPY 2"}, "JPY 5", "JPY 2", "JPY 1", "JPY 2"},
+		}
+		for _, tt := range tests {
+			var s Summer
+			for _, a := range tt.amounts {
+				if err := s.Add(mustParseSQLAmount(t, a)); err != nil {
+					t.Fatalf("Summer.Add(%q) failed: %v", a, err)
+				}
+			}
+			if got := s.Count(); got != len(tt.amounts) {
+				t.Errorf("Summer%v.Count() = %v, want %v", tt.amounts, got, len(tt.amounts))
+			}
+			got, err := s.Sum()
+			if err != nil {
+				t.Errorf("Summer%v.Sum() failed: %v", tt.amounts, err)
+			} else if want := mustParseSQLAmount(t, tt.wantSum); got != want {
+				t.Errorf("Summer%v.Sum() = %q, want %q", tt.amounts, got, want)
+			}
+			got, err = s.Mean(HalfEven)
+			if err != nil {
+				t.Errorf("Summer%v.Mean() failed: %v", tt.amounts, err)
+			} else if want := mustParseSQLAmount(t, tt.wantMean); got != want {
+				t.Errorf("Summer%v.Mean() = %q, want %q", tt.amounts, got, want)
+			}
+			got, err = s.Min()
+			if err != nil {
+				t.Errorf("Summer%v.Min() failed: %v", tt.amounts, err)
+			} else if want := mustParseSQLAmount(t, tt.wantMin); got != want {
+				t.Errorf("Summer%v.Min() = %q, want %q", tt.amounts, got, want)
+			}
+			got, err = s.Max()
+			if err != nil {
+				t.Errorf("Summer%v.Max() failed: %v", tt.amounts, err)
+			} else if want := mustParseSQLAmount(t, tt.wantMax); got != want {
+				t.Errorf("Summer%v.Max() = %q, want %q", tt.amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var s Summer
+		if _, err := s.Sum(); err == nil {
+			t.Errorf("Summer{}.Sum() did not fail")
+		}
+		if _, err := s.Mean(); err == nil {
+			t.Errorf("Summer{}.Mean() did not fail")
+		}
+		if _, err := s.Min(); err == nil {
+			t.Errorf("Summer{}.Min() did not fail")
+		}
+		if _, err := s.Max(); err == nil {
+			t.Errorf("Summer{}.Max() did not fail")
+		}
+
+		a := MustParseAmount("USD", "99999999999999999")
+		if err := s.Add(a); err != nil {
+			t.Fatalf("Summer.Add(%q) failed: %v", a, err)
+		}
+		if err := s.Add(a); err == nil {
+			t.Errorf("Summer.Add(%q) did not fail", a)
+		}
+		b := MustParseAmount("EUR", "1.00")
+		if err := s.Add(b); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Summer.Add(%q) = %v, want %v", b, err, ErrCurrencyMismatch)
+		}
+		if got := s.Count(); got != 1 {
+			t.Errorf("Summer.Count() = %v, want 1", got)
+		}
+		if got, _ := s.Sum(); got != a {
+			t.Errorf("Summer.Sum() = %q, want %q", got, a)
+		}
+
+		s.Reset()
+		if err := s.Add(b); err != nil {
+			t.Errorf("Summer.Add(%q) after Reset failed: %v", b, err)
+		}
+	})
+}
+
+func TestSum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts []string
+			want    string
+		}{
+			{[]string{"USD 1.00"}, "USD 1.00"},
+			{[]string{"USD 1.00", "USD 2.50", "USD -0.50"}, "USD 3.00"},
+			{[]string{"OMR 0.001", "OMR 0.002"}, "OMR 0.003"},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			for i, s := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, s)
+			}
+			got, err := Sum(slices.Values(amounts))
+			if err != nil {
+				t.Errorf("Sum(%v) failed: %v", amounts, err)
+				continue
+			}
+			if want := mustParseSQLAmount(t, tt.want); got != want {
+				t.Errorf("Sum(%v) = %q, want %q", amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"empty":             {},
+			"currency mismatch": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")},
+			"overflow":          {MustParseAmount("USD", "99999999999999999"), MustParseAmount("USD", "99999999999999999")},
+		}
+		for name, amounts := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := Sum(slices.Values(amounts))
+				if err == nil {
+					t.Errorf("Sum(%v) did not fail", amounts)
+				}
+			})
+		}
+	})
+}
+
+func TestMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts []string
+			mode    RoundingMode
+			want    string
+		}{
+			{[]string{"USD 1.00"}, HalfEven, "USD 1.00"},
+			{[]string{"USD 0.01", "USD 0.02"}, HalfEven, "USD 0.02"},
+			{[]string{"USD 0.01", "USD 0.02"}, HalfDown, "USD 0.01"},
+			{[]string{"USD 10.00", "USD 10.00", "USD 10.01"}, Ceiling, "USD 10.01"},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			for i, s := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, s)
+			}
+			got, err := Mean(slices.Values(amounts), tt.mode)
+			if err != nil {
+				t.Errorf("Mean(%v, %v) failed: %v", amounts, tt.mode, err)
+				continue
+			}
+			if want := mustParseSQLAmount(t, tt.want); got != want {
+				t.Errorf("Mean(%v, %v) = %q, want %q", amounts, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"empty":             {},
+			"currency mismatch": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")},
+			"overflow":          {MustParseAmount("USD", "99999999999999999"), MustParseAmount("USD", "99999999999999999")},
+		}
+		for name, amounts := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := Mean(slices.Values(amounts))
+				if err == nil {
+					t.Errorf("Mean(%v) did not fail", amounts)
+				}
+			})
+		}
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts          []string
+			wantMin, wantMax string
+		}{
+			{[]string{"USD 1.00"}, "USD 1.00", "USD 1.00"},
+			{[]string{"USD 3.00", "USD -1.00", "USD 2.00"}, "USD -1.00", "USD 3.00"},
+			{[]string{"USD 99999999999999999", "USD 99999999999999999"}, "USD 99999999999999999", "USD 99999999999999999"},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			for i, s := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, s)
+			}
+			got, err := Min(slices.Values(amounts))
+			if err != nil {
+				t.Errorf("Min(%v) failed: %v", amounts, err)
+			} else if want := mustParseSQLAmount(t, tt.wantMin); got != want {
+				t.Errorf("Min(%v) = %q, want %q", amounts, got, want)
+			}
+			got, err = Max(slices.Values(amounts))
+			if err != nil {
+				t.Errorf("Max(%v) failed: %v", amounts, err)
+			} else if want := mustParseSQLAmount(t, tt.wantMax); got != want {
+				t.Errorf("Max(%v) = %q, want %q", amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"empty":             {},
+			"currency mismatch": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")},
+		}
+		for name, amounts := range tests {
+			t.Run(name, func(t *testing.T) {
+				if _, err := Min(slices.Values(amounts)); err == nil {
+					t.Errorf("Min(%v) did not fail", amounts)
+				}
+				if _, err := Max(slices.Values(amounts)); err == nil {
+					t.Errorf("Max(%v) did not fail", amounts)
+				}
+			})
+		}
+	})
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"USD 1.00", "USD 1.00", 0},
+		{"USD 1.0", "USD 1.00", 0},
+		{"USD -1.00", "USD 1.00", -1},
+		{"USD 2.00", "USD 1.00", 1},
+		{"EUR 100.00", "USD 1.00", -1},
+		{"USD 1.00", "EUR 100.00", 1},
+		{"JPY 1", "GBP 1.00", 1},
+	}
+	for _, tt := range tests {
+		a, b := mustParseSQLAmount(t, tt.a), mustParseSQLAmount(t, tt.b)
+		if got := Compare(a, b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %v, want %v", a, b, got, tt.want)
+		}
+	}
+}
+
+func TestSortAscendingDescending(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts           []string
+			wantAsc, wantDesc []string
+		}{
+			{nil, nil, nil},
+			{[]string{"USD 1.00"}, []string{"USD 1.00"}, []string{"USD 1.00"}},
+			{
+				[]string{"USD 3.00", "USD -1.00", "USD 2.00"},
+				[]string{"USD -1.00", "USD 2.00", "USD 3.00"},
+				[]string{"USD 3.00", "USD 2.00", "USD -1.00"},
+			},
+			{
+				[]string{"USD 1.0", "USD 0.5", "USD 1.00", "USD 1"},
+				[]string{"USD 0.5", "USD 1.0", "USD 1.00", "USD 1"},
+				[]string{"USD 1.0", "USD 1.00", "USD 1", "USD 0.5"},
+			},
+		}
+		for _, tt := range tests {
+			parse := func(ss []string) []Amount {
+				amounts := make([]Amount, len(ss))
+				for i, s := range ss {
+					amounts[i] = mustParseSQLAmount(t, s)
+				}
+				return amounts
+			}
+			got := parse(tt.amounts)
+			if err := SortAscending(got); err != nil {
+				t.Errorf("SortAscending(%v) failed: %v", tt.amounts, err)
+			} else if want := parse(tt.wantAsc); !slices.Equal(got, want) {
+				t.Errorf("SortAscending(%v) = %v, want %v", tt.amounts, got, want)
+			}
+			got = parse(tt.amounts)
+			if err := SortDescending(got); err != nil {
+				t.Errorf("SortDescending(%v) failed: %v", tt.amounts, err)
+			} else if want := parse(tt.wantDesc); !slices.Equal(got, want) {
+				t.Errorf("SortDescending(%v) = %v, want %v", tt.amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		amounts := []Amount{MustParseAmount("USD", "2"), MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")}
+		want := slices.Clone(amounts)
+		if err := SortAscending(amounts); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("SortAscending(%v) = %v, want %v", amounts, err, ErrCurrencyMismatch)
+		}
+		if err := SortDescending(amounts); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("SortDescending(%v) = %v, want %v", amounts, err, ErrCurrencyMismatch)
+		}
+		if !slices.Equal(amounts, want) {
+			t.Errorf("amounts = %v, want unchanged %v", amounts, want)
+		}
+	})
+}
+
+func TestRunningBalance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var b RunningBalance
+		if _, ok := b.Curr(); ok {
+			t.Errorf("Curr() = _, true, want false")
+		}
+		if got, want := b.Snapshot(), (Amount{}); got != want {
+			t.Errorf("Snapshot() = %q, want %q", got, want)
+		}
+		if err := b.Add(MustParseAmount("USD", "10")); err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+		if err := b.Sub(MustParseAmount("USD", "12.505")); err != nil {
+			t.Fatalf("Sub() failed: %v", err)
+		}
+		if got, want := b.Snapshot(), MustParseAmount("USD", "-2.505"); got != want {
+			t.Errorf("Snapshot() = %q, want %q", got, want)
+		}
+		if c, ok := b.Curr(); !ok || c != USD {
+			t.Errorf("Curr() = %v, %v, want %v, true", c, ok, USD)
+		}
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		b := NewRunningBalance(JPY)
+		if got, want := b.Snapshot(), MustParseAmount("JPY", "0"); got != want {
+			t.Errorf("Snapshot() = %q, want %q", got, want)
+		}
+		if err := b.Add(MustParseAmount("USD", "1")); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Add() = %v, want %v", err, ErrCurrencyMismatch)
+		}
+		if err := b.Sub(MustParseAmount("USD", "1")); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Sub() = %v, want %v", err, ErrCurrencyMismatch)
+		}
+		if c, ok := b.Curr(); !ok || c != JPY {
+			t.Errorf("Curr() = %v, %v, want %v, true", c, ok, JPY)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var b RunningBalance
+		large := MustParseAmount("USD", "99999999999999999")
+		if err := b.Add(large); err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+		if err := b.Add(large); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Add() = %v, want %v", err, ErrOverflow)
+		}
+		if err := b.Add(MustParseAmount("EUR", "1")); !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("Add() = %v, want %v", err, ErrCurrencyMismatch)
+		}
+		if got := b.Snapshot(); got != large {
+			t.Errorf("Snapshot() = %q, want unchanged %q", got, large)
+		}
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var b RunningBalance
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 1000 {
+					_ = b.Add(MustParseAmount("USD", "0.03"))
+					_ = b.Sub(MustParseAmount("USD", "0.01"))
+				}
+			}()
+		}
+		wg.Wait()
+		if got, want := b.Snapshot(), MustParseAmount("USD", "160.00"); got != want {
+			t.Errorf("Snapshot() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestTotal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts []string
+			want    string
+		}{
+			{[]string{"USD 1.00"}, "USD 1.00"},
+			{[]string{"USD 1.00", "USD 2.50", "USD -0.50"}, "USD 3.00"},
+			{[]string{"USD 1", "USD 0.005"}, "USD 1.005"},
+			{[]string{"OMR 0.001", "OMR 0.002"}, "OMR 0.003"},
+			{[]string{"JPY 1", "JPY 2"}, "JPY 3"},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			for i, s := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, s)
+			}
+			got, err := Total(amounts)
+			if err != nil {
+				t.Errorf("Total(%v) failed: %v", amounts, err)
+				continue
+			}
+			if want := mustParseSQLAmount(t, tt.want); got != want {
+				t.Errorf("Total(%v) = %q, want %q", amounts, got, want)
+			}
+			if want, _ := Sum(slices.Values(amounts)); got != want {
+				t.Errorf("Total(%v) = %q, want Sum %q", amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			amounts []Amount
+			want    error
+		}{
+			"empty":             {[]Amount{}, errNoAmounts},
+			"currency mismatch": {[]Amount{MustParseAmount("USD", "1"), MustParseAmount("USD", "2"), MustParseAmount("EUR", "1")}, ErrCurrencyMismatch},
+			"overflow":          {[]Amount{MustParseAmount("USD", "99999999999999999"), MustParseAmount("USD", "99999999999999999")}, ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := Total(tt.amounts)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("Total(%v) = %v, want %v", tt.amounts, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts          []string
+			wantMin, wantMax string
+		}{
+			{[]string{"USD 1.00"}, "USD 1.00", "USD 1.00"},
+			{[]string{"USD 3.00", "USD -1.00", "USD 2.00"}, "USD -1.00", "USD 3.00"},
+			{[]string{"USD 1.0", "USD 1.00", "USD 1"}, "USD 1.00", "USD 1"},
+			{[]string{"JPY 5", "JPY 5", "JPY -5"}, "JPY -5", "JPY 5"},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			for i, s := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, s)
+			}
+			gotMin, err := MinOf(amounts)
+			if err != nil {
+				t.Errorf("MinOf(%v) failed: %v", amounts, err)
+				continue
+			}
+			gotMax, err := MaxOf(amounts)
+			if err != nil {
+				t.Errorf("MaxOf(%v) failed: %v", amounts, err)
+				continue
+			}
+			if want := mustParseSQLAmount(t, tt.wantMin); gotMin != want {
+				t.Errorf("MinOf(%v) = %q, want %q", amounts, gotMin, want)
+			}
+			if want := mustParseSQLAmount(t, tt.wantMax); gotMax != want {
+				t.Errorf("MaxOf(%v) = %q, want %q", amounts, gotMax, want)
+			}
+			if want, _ := Min(slices.Values(amounts)); gotMin != want {
+				t.Errorf("MinOf(%v) = %q, want Min %q", amounts, gotMin, want)
+			}
+			if want, _ := Max(slices.Values(amounts)); gotMax != want {
+				t.Errorf("MaxOf(%v) = %q, want Max %q", amounts, gotMax, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			amounts []Amount
+			want    error
+		}{
+			"empty":             {nil, errNoAmounts},
+			"currency mismatch": {[]Amount{MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")}, ErrCurrencyMismatch},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				if _, err := MinOf(tt.amounts); !errors.Is(err, tt.want) {
+					t.Errorf("MinOf(%v) = %v, want %v", tt.amounts, err, tt.want)
+				}
+				if _, err := MaxOf(tt.amounts); !errors.Is(err, tt.want) {
+					t.Errorf("MaxOf(%v) = %v, want %v", tt.amounts, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestClampSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			amounts  []string
+			min, max string
+			want     []string
+		}{
+			{nil, "USD 0", "USD 10", nil},
+			{
+				[]string{"USD -1.00", "USD 5.00", "USD 11.00"},
+				"USD 0.00", "USD 10.00",
+				[]string{"USD 0.00", "USD 5.00", "USD 10.00"},
+			},
+			{
+				[]string{"USD 1.0", "USD 1.00", "USD 1"},
+				"USD 1.0", "USD 1.0",
+				[]string{"USD 1.0", "USD 1.0", "USD 1.0"},
+			},
+			{
+				[]string{"USD 1.0", "USD 1.00", "USD 1", "USD 0.5"},
+				"USD 1", "USD 1.00",
+				[]string{"USD 1.0", "USD 1.00", "USD 1", "USD 1.00"},
+			},
+		}
+		for _, tt := range tests {
+			amounts := make([]Amount, len(tt.amounts))
+			want := make([]Amount, len(tt.want))
+			for i := range tt.amounts {
+				amounts[i] = mustParseSQLAmount(t, tt.amounts[i])
+				want[i] = mustParseSQLAmount(t, tt.want[i])
+			}
+			lo, hi := mustParseSQLAmount(t, tt.min), mustParseSQLAmount(t, tt.max)
+			for i, a := range amounts {
+				if c, err := a.Clamp(lo, hi); err != nil || c != want[i] {
+					t.Errorf("%q.Clamp(%q, %q) = %q, %v, want %q", a, lo, hi, c, err, want[i])
+				}
+			}
+			if err := ClampSlice(amounts, lo, hi); err != nil {
+				t.Errorf("ClampSlice(%v, %q, %q) failed: %v", tt.amounts, lo, hi, err)
+				continue
+			}
+			if !slices.Equal(amounts, want) {
+				t.Errorf("ClampSlice(%v, %q, %q) = %v, want %v", tt.amounts, lo, hi, amounts, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			amounts  []Amount
+			min, max Amount
+		}{
+			"amounts mismatch": {[]Amount{MustParseAmount("USD", "20"), MustParseAmount("EUR", "1")}, MustParseAmount("USD", "0"), MustParseAmount("USD", "10")},
+			"range mismatch":   {[]Amount{MustParseAmount("USD", "20")}, MustParseAmount("USD", "0"), MustParseAmount("EUR", "10")},
+			"bounds mismatch":  {[]Amount{MustParseAmount("USD", "20")}, MustParseAmount("EUR", "0"), MustParseAmount("EUR", "10")},
+			"invalid range":    {[]Amount{MustParseAmount("USD", "20")}, MustParseAmount("USD", "10"), MustParseAmount("USD", "0")},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				want := slices.Clone(tt.amounts)
+				if err := ClampSlice(tt.amounts, tt.min, tt.max); err == nil {
+					t.Errorf("ClampSlice(%v, %q, %q) did not fail", tt.amounts, tt.min, tt.max)
+				}
+				if !slices.Equal(tt.amounts, want) {
+					t.Errorf("amounts = %v, want unchanged %v", tt.amounts, want)
+				}
+			})
+		}
+	})
+}
+
+func BenchmarkRunningBalance_Add(b *testing.B) {
+	var bal RunningBalance
+	a := MustParseAmount("USD", "0.01")
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = bal.Add(a)
+		}
+	})
+}
+
+func BenchmarkTotal(b *testing.B) {
+	amounts := make([]Amount, 1000)
+	for i := range amounts {
+		amounts[i] = MustNewAmount("USD", int64(i), 2)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := Total(amounts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMaxOf(b *testing.B) {
+	amounts := make([]Amount, 1000)
+	for i := range amounts {
+		amounts[i] = MustNewAmount("USD", int64(i), 2)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := MaxOf(amounts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
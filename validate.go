@@ -0,0 +1,122 @@
+package money
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Validate checks that an amount received from a client, for example in
+// a gRPC or REST request, can be processed:
+//   - its currency is known and is not [XXX];
+//   - it has no more digits after the decimal point than the scale of its
+//     currency, ignoring trailing zeros, so that "USD 1.50" and "USD 1.500"
+//     are valid, but "USD 1.505" is not;
+//   - it can be converted to minor units that fit in an int64, see
+//     [Amount.MinorUnits].
+//
+// Validate returns an error if any of the checks fails:
+//   - [UnknownCurrencyError] for an unknown currency;
+//   - [InvalidAmountError] for too many digits after the decimal point;
+//   - [ErrOverflow] for minor units that do not fit in an int64.
+//
+// See also function [ValidateValue] for validating struct fields.
+func Validate(a Amount) error {
+	if err := validate(a); err != nil {
+		return fmt.Errorf("validating %v: %w", a, err)
+	}
+	return nil
+}
+
+func validate(a Amount) error {
+	m := a.Curr()
+	if m == XXX {
+		return &UnknownCurrencyError{Code: m.Code()}
+	}
+	if a.MinScale() > m.Scale() {
+		return &InvalidAmountError{
+			Input: a.Decimal().String(),
+			Err:   fmt.Errorf("more than %v digits after the decimal point", m.Scale()),
+		}
+	}
+	if _, ok := a.MinorUnits(); !ok {
+		return ErrOverflow
+	}
+	return nil
+}
+
+// ValidateValue is like [Validate], but accepts a value of any of the types
+// that hold amounts: [Amount], [NullAmount], [TextAmount], and [MinorUnits],
+// or pointers to them.
+// Null amounts and nil pointers are valid, use a "required" rule to reject
+// them.
+// The value can also be a [reflect.Value], so that ValidateValue can be
+// registered as a custom validation in libraries such as
+// [go-playground/validator]:
+//
+//	v := validator.New()
+//	v.RegisterValidation("money", func(fl validator.FieldLevel) bool {
+//		return money.ValidateValue(fl.Field()) == nil
+//	})
+//
+//	type PaymentRequest struct {
+//		Amount money.Amount `validate:"money"`
+//	}
+//
+// ValidateValue returns an error if the value is not valid according to
+// [Validate], or if it is of a different type.
+//
+// [go-playground/validator]: https://github.com/go-playground/validator
+func ValidateValue(v any) error {
+	if r, ok := v.(reflect.Value); ok {
+		if !r.IsValid() {
+			return nil
+		}
+		if !r.CanInterface() {
+			return fmt.Errorf("validating %v: unexported field", r.Type())
+		}
+		v = r.Interface()
+	}
+	switch v := v.(type) {
+	case Amount:
+		return Validate(v)
+	case *Amount:
+		if v == nil {
+			return nil
+		}
+		return Validate(*v)
+	case NullAmount:
+		if !v.Valid {
+			return nil
+		}
+		return Validate(v.Amount)
+	case *NullAmount:
+		if v == nil {
+			return nil
+		}
+		return ValidateValue(*v)
+	case TextAmount:
+		return Validate(v.Amount)
+	case *TextAmount:
+		if v == nil {
+			return nil
+		}
+		return Validate(v.Amount)
+	case MinorUnits:
+		return validateMinorUnits(v)
+	case *MinorUnits:
+		if v == nil {
+			return nil
+		}
+		return validateMinorUnits(*v)
+	default:
+		return fmt.Errorf("validating %T: not an amount", v)
+	}
+}
+
+func validateMinorUnits(m MinorUnits) error {
+	a, err := m.Amount()
+	if err != nil {
+		return fmt.Errorf("validating %v %v minor units: %w", m.Units, m.Curr, err)
+	}
+	return Validate(a)
+}
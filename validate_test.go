@@ -0,0 +1,120 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{
+			"USD 0",
+			"USD 1.50",
+			"USD 1.500",
+			"USD -1.99",
+			"JPY 100",
+			"JPY 100.00",
+			"OMR 1.005",
+			"USD 92233720368547758.07",
+			"USD -92233720368547758.08",
+		}
+		for _, tt := range tests {
+			a := mustParseSQLAmount(t, tt)
+			if err := Validate(a); err != nil {
+				t.Errorf("Validate(%q) failed: %v", a, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			a      Amount
+			target any
+		}{
+			"unknown currency": {Amount{}, new(*UnknownCurrencyError)},
+			"scale 1":          {mustParseSQLAmount(t, "USD 1.505"), new(*InvalidAmountError)},
+			"scale 2":          {mustParseSQLAmount(t, "JPY 1.5"), new(*InvalidAmountError)},
+			"overflow 1":       {mustParseSQLAmount(t, "USD 92233720368547758.08"), &ErrOverflow},
+			"overflow 2":       {mustParseSQLAmount(t, "USD -92233720368547758.09"), &ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := Validate(tt.a)
+				if err == nil {
+					t.Fatalf("Validate(%q) did not fail", tt.a)
+				}
+				if target, ok := tt.target.(*error); ok {
+					if !errors.Is(err, *target) {
+						t.Errorf("Validate(%q) = %v, want %v", tt.a, err, *target)
+					}
+					return
+				}
+				if !errors.As(err, tt.target) {
+					t.Errorf("Validate(%q) = %v, want %T", tt.a, err, tt.target)
+				}
+			})
+		}
+	})
+}
+
+func TestValidateValue(t *testing.T) {
+	good := mustParseSQLAmount(t, "USD 1.50")
+	bad := mustParseSQLAmount(t, "USD 1.505")
+
+	type request struct {
+		Amount Amount
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []any{
+			good,
+			&good,
+			(*Amount)(nil),
+			NullAmount{},
+			NullAmount{Amount: good, Valid: true},
+			NullAmount{Amount: bad, Valid: false},
+			&NullAmount{Amount: good, Valid: true},
+			(*NullAmount)(nil),
+			TextAmount{good},
+			&TextAmount{good},
+			(*TextAmount)(nil),
+			MinorUnits{Curr: USD, Units: 150},
+			&MinorUnits{Curr: JPY, Units: -150},
+			(*MinorUnits)(nil),
+			reflect.ValueOf(good),
+			reflect.ValueOf(request{good}).Field(0),
+			reflect.Value{},
+		}
+		for _, tt := range tests {
+			if err := ValidateValue(tt); err != nil {
+				t.Errorf("ValidateValue(%v) failed: %v", tt, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{
+			nil,
+			"USD 1.50",
+			1.5,
+			bad,
+			&bad,
+			Amount{},
+			NullAmount{Amount: bad, Valid: true},
+			&NullAmount{Amount: bad, Valid: true},
+			TextAmount{bad},
+			&TextAmount{bad},
+			MinorUnits{},
+			&MinorUnits{},
+			reflect.ValueOf(bad),
+			reflect.ValueOf(request{bad}).Field(0),
+			reflect.ValueOf(struct{ a Amount }{good}).Field(0),
+		}
+		for _, tt := range tests {
+			if err := ValidateValue(tt); err == nil {
+				t.Errorf("ValidateValue(%v) did not fail", tt)
+			}
+		}
+	})
+}